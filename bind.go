@@ -0,0 +1,291 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"fmt"
+	"mime/multipart"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	formDataFileType = reflect.TypeOf(FormDataFile(nil))
+	fileType         = reflect.TypeOf((*File)(nil))
+	fileHeaderType   = reflect.TypeOf((*multipart.FileHeader)(nil))
+	fileHeadersType  = reflect.TypeOf([]*multipart.FileHeader(nil))
+	timeType         = reflect.TypeOf(time.Time{})
+	durationType     = reflect.TypeOf(time.Duration(0))
+)
+
+// Bind populates the fields of the struct dst points to with the values and
+// files of FormData. The key of a field is taken from its `form` tag or its
+// name, fields tagged with `form:"-"` and unexported fields are skipped.
+// Fields of embedded structs are bound like fields of dst.
+//
+// Supported field types are strings, integers, floats, bools ("on" is true),
+// time.Duration and time.Time, parsed with the layout of the `layout` tag or
+// time.RFC3339. Slices of these types receive all values of a key, pointers
+// stay nil if a key is missing. Files are bound to FormDataFile, *File,
+// *multipart.FileHeader and []*multipart.FileHeader fields.
+//
+// Fields of embedded structs and pointers to structs are bound as well, nil
+// pointers are allocated. Fields of missing keys and empty values of
+// non-string fields are left unchanged, empty elements of slices are zero.
+// Values which cannot be converted are reported as validation errors, in
+// slices they stay zero. Bind only fails if dst is not a pointer to a struct
+// or a field has an unsupported type.
+//
+//	var mail struct {
+//		From        string                `form:"from"`
+//		To          []string              `form:"to"`
+//		SendAt      *time.Time            `form:"send_at" layout:"2006-01-02"`
+//		Attachments formdata.FormDataFile `form:"attachment"`
+//	}
+//	if err := fd.Bind(&mail); err != nil {
+//		// ...handle invalid struct
+//	}
+func (fd *FormData) Bind(dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind requires a non-nil pointer to a struct, got: %T", dst)
+	}
+	return fd.bindStruct(rv.Elem())
+}
+
+// Unmarshal binds fd into the struct v points to like FormData.Bind. Values
+// which cannot be converted are returned as error besides being added to the
+// validation errors of fd.
+func Unmarshal(fd *FormData, v interface{}) error {
+	n := len(fd.errors)
+	if err := fd.Bind(v); err != nil {
+		return err
+	}
+	if len(fd.errors) == n {
+		return nil
+	}
+	errs := &errorList{}
+	for _, err := range fd.errors[n:] {
+		errs.errs = append(errs.errs, err)
+	}
+	return errs
+}
+
+func (fd *FormData) bindStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			if err := fd.bindStruct(v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if sf.Anonymous && sf.Type.Kind() == reflect.Ptr && sf.Type.Elem().Kind() == reflect.Struct {
+			fv := v.Field(i)
+			if fv.IsNil() {
+				if !fv.CanSet() {
+					// unexported embedded pointers cannot be allocated
+					continue
+				}
+				fv.Set(reflect.New(sf.Type.Elem()))
+			}
+			if err := fd.bindStruct(fv.Elem()); err != nil {
+				return err
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		key := sf.Tag.Get("form")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = sf.Name
+		}
+		if err := fd.bindField(key, sf, v.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fd *FormData) bindField(key string, sf reflect.StructField, fv reflect.Value) error {
+	switch fv.Type() {
+	case formDataFileType:
+		if files := fd.GetFile(key); len(files) > 0 {
+			fv.Set(reflect.ValueOf(files))
+		}
+		return nil
+	case fileType:
+		if f := fd.GetFile(key).First(); f != nil {
+			fv.Set(reflect.ValueOf(f))
+		}
+		return nil
+	case fileHeaderType:
+		if headers := fd.File[key]; len(headers) > 0 {
+			fv.Set(reflect.ValueOf(headers[0]))
+		}
+		return nil
+	case fileHeadersType:
+		if headers := fd.File[key]; len(headers) > 0 {
+			fv.Set(reflect.ValueOf(headers))
+		}
+		return nil
+	}
+
+	t := fv.Type()
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !bindable(t) {
+		return fmt.Errorf("bind does not support field %s of type %s", sf.Name, fv.Type())
+	}
+
+	values := fd.Get(key)
+	if len(values) == 0 {
+		return nil
+	}
+	layout := sf.Tag.Get("layout")
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	validation := fd.Validate(key)
+
+	if fv.Kind() == reflect.Slice {
+		// elements which cannot be converted stay zero, so the indices of
+		// the slice match the indices of the values
+		s := reflect.MakeSlice(fv.Type(), len(values), len(values))
+		for i, value := range values {
+			if value == "" && t.Kind() != reflect.String {
+				continue
+			}
+			ev := reflect.New(t).Elem()
+			if err := setValue(ev, value, layout); err != nil {
+				validation.addConversionError(i, t.String())
+				continue
+			}
+			s.Index(i).Set(ev)
+		}
+		fv.Set(s)
+		return nil
+	}
+
+	value := values.First()
+	if value == "" && t.Kind() != reflect.String {
+		return nil
+	}
+	ev := reflect.New(t)
+	if err := setValue(ev.Elem(), value, layout); err != nil {
+		validation.addConversionError(0, t.String())
+		return nil
+	}
+	if fv.Kind() == reflect.Ptr {
+		fv.Set(ev)
+	} else {
+		fv.Set(ev.Elem())
+	}
+	return nil
+}
+
+// bindable reports whether a single value can be bound to type t.
+func bindable(t reflect.Type) bool {
+	if t == timeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// setValue converts s to the type of v and sets v.
+func setValue(v reflect.Value, s, layout string) error {
+	switch v.Type() {
+	case timeType:
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	case durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := parseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	}
+	return nil
+}
+
+// parseBool parses s like strconv.ParseBool and accepts "on", the value of
+// checked HTML checkboxes without value attribute.
+func parseBool(s string) (bool, error) {
+	if s == "on" {
+		return true, nil
+	}
+	return strconv.ParseBool(s)
+}
@@ -0,0 +1,202 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"mime/multipart"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	City string `form:"city"`
+}
+
+type testUser struct {
+	testAddress
+	Name       string                  `form:"name"`
+	Age        int                     `form:"age"`
+	Score      float64                 `form:"score"`
+	Admin      bool                    `form:"admin"`
+	Newsletter *bool                   `form:"newsletter"`
+	Tags       []string                `form:"tags"`
+	Lucky      []uint8                 `form:"lucky"`
+	Born       time.Time               `form:"born" layout:"2006-01-02"`
+	Seen       *time.Time              `form:"seen"`
+	Timeout    time.Duration           `form:"timeout"`
+	Nickname   *string                 `form:"nickname"`
+	Ignored    string                  `form:"-"`
+	Avatar     *File                   `form:"avatar"`
+	Photos     FormDataFile            `form:"photos"`
+	Header     *multipart.FileHeader   `form:"avatar"`
+	Headers    []*multipart.FileHeader `form:"photos"`
+	Default    string
+	hidden     string
+}
+
+func TestBind(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["city"] = []string{"Vienna"}
+	fd.Value["name"] = []string{"neox5", "ignored"}
+	fd.Value["age"] = []string{"42"}
+	fd.Value["score"] = []string{"9.5"}
+	fd.Value["admin"] = []string{"on"}
+	fd.Value["tags"] = []string{"go", "form"}
+	fd.Value["lucky"] = []string{"7", "", "13"}
+	fd.Value["born"] = []string{"2021-03-28"}
+	fd.Value["seen"] = []string{"2026-10-17T10:00:00Z"}
+	fd.Value["timeout"] = []string{"1m30s"}
+	fd.Value["Ignored"] = []string{"value"}
+	fd.Value["Default"] = []string{"by name"}
+	fd.Value["hidden"] = []string{"value"}
+	avatar := &multipart.FileHeader{Filename: "me.png"}
+	photos := []*multipart.FileHeader{{Filename: "a.jpg"}, {Filename: "b.jpg"}}
+	fd.File["avatar"] = []*multipart.FileHeader{avatar}
+	fd.File["photos"] = photos
+
+	var user testUser
+	if err := fd.Bind(&user); err != nil {
+		t.Fatal(err)
+	}
+	if fd.HasErrors() {
+		t.Fatalf("Unexpected errors: %v", fd.Errors())
+	}
+
+	expected := testUser{
+		testAddress: testAddress{City: "Vienna"},
+		Name:        "neox5",
+		Age:         42,
+		Score:       9.5,
+		Admin:       true,
+		Tags:        []string{"go", "form"},
+		Lucky:       []uint8{7, 0, 13},
+		Born:        time.Date(2021, 3, 28, 0, 0, 0, 0, time.UTC),
+		Timeout:     90 * time.Second,
+		Header:      avatar,
+		Headers:     photos,
+		Default:     "by name",
+	}
+	seen := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	if user.Seen == nil || !user.Seen.Equal(seen) {
+		t.Errorf("Invalid Seen: expected: %v, got: %v", seen, user.Seen)
+	}
+	if user.Avatar == nil || user.Avatar.Filename != "me.png" {
+		t.Errorf("Invalid Avatar: got: %v", user.Avatar)
+	}
+	if len(user.Photos) != 2 || user.Photos.At(1).Filename != "b.jpg" {
+		t.Errorf("Invalid Photos: got: %d files", len(user.Photos))
+	}
+	if user.Newsletter != nil || user.Nickname != nil {
+		t.Errorf("Missing keys set: got: %v, %v", user.Newsletter, user.Nickname)
+	}
+	user.Seen, user.Avatar, user.Photos = nil, nil, nil
+	if !reflect.DeepEqual(user, expected) {
+		t.Errorf("Invalid struct: expected: %+v, got: %+v", expected, user)
+	}
+}
+
+func TestBindEmbeddedPointer(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["city"] = []string{"Vienna"}
+	fd.Value["name"] = []string{"neox5"}
+
+	var profile struct {
+		*testAddress
+		Name string `form:"name"`
+	}
+	if err := fd.Bind(&profile); err != nil {
+		t.Fatal(err)
+	}
+	if profile.testAddress != nil {
+		t.Errorf("Unexported embedded pointer allocated: got: %+v", profile.testAddress)
+	}
+
+	type Address testAddress
+	var exported struct {
+		*Address
+		Name string `form:"name"`
+	}
+	if err := fd.Bind(&exported); err != nil {
+		t.Fatal(err)
+	}
+	if exported.Address == nil || exported.City != "Vienna" || exported.Name != "neox5" {
+		t.Errorf("Invalid struct: got: %+v, %+v", exported.Address, exported)
+	}
+
+	existing := &Address{City: "Graz"}
+	exported.Address = existing
+	fd.Value["city"] = []string{"Linz"}
+	if err := fd.Bind(&exported); err != nil {
+		t.Fatal(err)
+	}
+	if exported.Address != existing || existing.City != "Linz" {
+		t.Errorf("Existing embedded pointer not reused: got: %+v", exported.Address)
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["age"] = []string{"old"}
+	fd.Value["lucky"] = []string{"7", "300", "13"}
+	fd.Value["born"] = []string{"28.03.2021"}
+
+	var user testUser
+	if err := fd.Bind(&user); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []uint8{7, 0, 13}; !reflect.DeepEqual(user.Lucky, expected) {
+		t.Errorf("Invalid slice: expected: %v, got: %v", expected, user.Lucky)
+	}
+	expected := []string{
+		"'age': Element 0 is not a valid int",
+		"'lucky': Element 1 is not a valid uint8",
+		"'born': Element 0 is not a valid time.Time",
+	}
+	if got := fd.Errors(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Invalid errors: expected: %v, got: %v", expected, got)
+	}
+
+	fd = emptyFormData()
+	fd.Value["age"] = []string{"old"}
+	err := Unmarshal(fd, &user)
+	if err == nil || err.Error() != "'age': Element 0 is not a valid int" {
+		t.Errorf("Invalid error: got: %v", err)
+	}
+
+	invalid := []interface{}{
+		user,
+		(*testUser)(nil),
+		new(string),
+		&struct{ Map map[string]string }{},
+	}
+	for _, dst := range invalid {
+		if err := fd.Bind(dst); err == nil {
+			t.Errorf("Bind of %T succeeded", dst)
+		}
+	}
+}
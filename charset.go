@@ -0,0 +1,110 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"encoding/binary"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	// charsetField is the form-data key browsers use to submit the charset of
+	// the form, see the HTML5 "_charset_" convention.
+	charsetField = "_charset_"
+)
+
+// windows1252 maps the bytes 0x80 to 0x9F of Windows-1252 to runes, all other
+// bytes are equal to ISO-8859-1.
+var windows1252 = [32]rune{
+	0x20AC, 0x0081, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0x008D, 0x017D, 0x008F,
+	0x0090, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0x009D, 0x017E, 0x0178,
+}
+
+// decodeCharset converts b from the given charset to valid UTF-8. An empty
+// charset is treated as UTF-8. Invalid UTF-8 sequences are replaced by
+// utf8.RuneError or, if rejectInvalid is set, fail with ErrInvalidUTF8.
+func decodeCharset(b []byte, charset string, rejectInvalid bool) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(charset)) {
+	case "", "utf-8", "utf8", "us-ascii", "ascii":
+		if utf8.Valid(b) {
+			return string(b), nil
+		}
+		if rejectInvalid {
+			return "", ErrInvalidUTF8
+		}
+		return strings.ToValidUTF8(string(b), string(utf8.RuneError)), nil
+	case "iso-8859-1", "iso8859-1", "latin1", "l1":
+		return decodeSingleByte(b, nil), nil
+	case "windows-1252", "cp1252":
+		return decodeSingleByte(b, &windows1252), nil
+	case "utf-16le":
+		return decodeUTF16(b, binary.LittleEndian), nil
+	case "utf-16", "utf-16be":
+		// RFC 2781 section 4.3: UTF-16 without byte order mark is big-endian
+		return decodeUTF16(b, binary.BigEndian), nil
+	}
+	return "", ErrUnsupportedCharset
+}
+
+// decodeSingleByte decodes ISO-8859-1 and, if high is set, Windows-1252.
+func decodeSingleByte(b []byte, high *[32]rune) string {
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for _, c := range b {
+		if high != nil && c >= 0x80 && c <= 0x9F {
+			sb.WriteRune(high[c-0x80])
+			continue
+		}
+		sb.WriteRune(rune(c))
+	}
+	return sb.String()
+}
+
+// decodeUTF16 decodes UTF-16 with the given byte order, unless b starts with a
+// byte order mark.
+func decodeUTF16(b []byte, order binary.ByteOrder) string {
+	switch {
+	case len(b) >= 2 && b[0] == 0xFF && b[1] == 0xFE:
+		order, b = binary.LittleEndian, b[2:]
+	case len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF:
+		order, b = binary.BigEndian, b[2:]
+	}
+
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		u = append(u, order.Uint16(b[i:]))
+	}
+	s := string(utf16.Decode(u))
+	if len(b)%2 == 1 {
+		s += string(utf8.RuneError)
+	}
+	return s
+}
@@ -0,0 +1,143 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+)
+
+func TestDecodeCharset(t *testing.T) {
+	testcases := []struct {
+		input    []byte
+		charset  string
+		expected string
+	}{
+		{[]byte("Grüße"), "", "Grüße"},
+		{[]byte("Grüße"), "UTF-8", "Grüße"},
+		{[]byte("Gr\xfc\xdfe"), "", "Gr\uFFFDe"},
+		{[]byte("Gr\xfc\xdfe"), "ISO-8859-1", "Grüße"},
+		{[]byte("\x80 \x93quoted\x94"), "windows-1252", "€ “quoted”"},
+		{[]byte("\x80"), "latin1", "\u0080"},
+		{[]byte("\xff\xfeG\x00r\x00\xfc\x00"), "UTF-16", "Grü"},
+		{[]byte("\xfe\xff\x00G\x00r\x00\xfc"), "utf-16", "Grü"},
+		{[]byte("\x00G\x00r"), "utf-16be", "Gr"},
+		{[]byte("\x00h\x00i"), "utf-16", "hi"},
+		{[]byte("h\x00i\x00"), "utf-16le", "hi"},
+	}
+
+	for _, testcase := range testcases {
+		got, err := decodeCharset(testcase.input, testcase.charset, false)
+		if err != nil {
+			t.Errorf("decodeCharset %q (%s): %v", testcase.input, testcase.charset, err)
+			continue
+		}
+		if got != testcase.expected {
+			t.Errorf("Invalid decoding of %q (%s): expected: %q, got: %q", testcase.input, testcase.charset, testcase.expected, got)
+		}
+	}
+
+	if _, err := decodeCharset([]byte("Gr\xfc\xdfe"), "utf-8", true); err != ErrInvalidUTF8 {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrInvalidUTF8, err)
+	}
+	if _, err := decodeCharset([]byte("abc"), "shift_jis", false); err != ErrUnsupportedCharset {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrUnsupportedCharset, err)
+	}
+}
+
+func TestParseCharset(t *testing.T) {
+	body := bytes.NewBuffer([]byte{})
+	multipartWriter := multipart.NewWriter(body)
+
+	multipartWriter.WriteField("before", "Gr\xfc\xdfe")
+	multipartWriter.WriteField("_charset_", "windows-1252")
+	multipartWriter.WriteField("after", "\x93Gr\xfc\xdfe\x94")
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="declared"`)
+	header.Set("Content-Type", "text/plain; charset=utf-8")
+	w, _ := multipartWriter.CreatePart(header)
+	w.Write([]byte("Grüße"))
+	multipartWriter.Close()
+
+	fd, err := ParseBytes(multipartWriter.FormDataContentType(), body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"before":   "Gr\uFFFDe",
+		"after":    "“Grüße”",
+		"declared": "Grüße",
+	}
+	for key, value := range expected {
+		if got := fd.Get(key).First(); got != value {
+			t.Errorf("Invalid value for %s: expected: %q, got: %q", key, value, got)
+		}
+	}
+
+	_, err = ParseReader(bytes.NewReader(body.Bytes()), multipartWriter.Boundary(), ParseOptions{RejectInvalidUTF8: true})
+	if !errors.Is(err, ErrInvalidUTF8) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrInvalidUTF8, err)
+	}
+
+	fd, err = ParseReader(bytes.NewReader(body.Bytes()), multipartWriter.Boundary(), ParseOptions{DefaultCharset: "iso-8859-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fd.Get("before").First(); got != "Grüße" {
+		t.Errorf("Invalid value for before: expected: %q, got: %q", "Grüße", got)
+	}
+
+	fd, err = ParseAny(testRequestURLEncoded(t, "_charset_=ISO-8859-1&name=Gr%FC%DFe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fd.Get("name").First(); got != "Grüße" {
+		t.Errorf("Invalid urlencoded value for name: expected: %q, got: %q", "Grüße", got)
+	}
+
+	// the charset of the request takes precedence over DefaultCharset
+	r := testRequestURLEncoded(t, "name=Gr%FC%DFe")
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=ISO-8859-1")
+	fd, err = ParseWithOptions(r, ParseOptions{AllowURLEncoded: true, DefaultCharset: "utf-8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fd.Get("name").First(); got != "Grüße" {
+		t.Errorf("Invalid urlencoded value with charset: expected: %q, got: %q", "Grüße", got)
+	}
+	fd, err = ParseWithOptions(testRequestURLEncoded(t, "name=Gr%FC%DFe"), ParseOptions{AllowURLEncoded: true, DefaultCharset: "latin1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fd.Get("name").First(); got != "Grüße" {
+		t.Errorf("Invalid urlencoded value with DefaultCharset: expected: %q, got: %q", "Grüße", got)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMinRateGracePeriod is the time a body may stay below
+	// ParseOptions.MinBytesPerSecond if ParseOptions.MinRateGracePeriod is
+	// zero.
+	DefaultMinRateGracePeriod = 5 * time.Second
+)
+
+// ParseContext parses a request body like ParseWithOptions, but stops reading
+// as soon as ctx is done, e.g. when a handler deadline expires or the client
+// goes away. All files stored so far are removed and the returned error wraps
+// ctx.Err(), so errors.Is(err, context.DeadlineExceeded) reports an expired
+// deadline.
+//
+// A read blocked on the body is abandoned rather than interrupted. Only the
+// handler is freed: the goroutine of the read and the connection stay blocked
+// until the client sends more data, as net/http does not interrupt a blocked
+// read when the body is closed. Set http.Server.ReadTimeout and
+// ReadHeaderTimeout to protect against clients which stop sending.
+// opts.AutoClose is bound to the context of r, not to ctx.
+func ParseContext(ctx context.Context, r *http.Request, opts ParseOptions) (*FormData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("parsing aborted: %w", err)
+	}
+
+	fd, err := parseRequest(ctx, r, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("parsing aborted: %w", ctx.Err())
+		}
+		return nil, err
+	}
+	return fd, nil
+}
+
+type readResult struct {
+	n   int
+	err error
+}
+
+// contextReader reads from r until ctx is done or the body is sent too
+// slowly. Every read runs in its own goroutine with a buffer owned by
+// contextReader, so an abandoned read never writes into the buffer of the
+// caller.
+type contextReader struct {
+	ctx     context.Context
+	r       io.Reader
+	buf     []byte
+	results chan readResult
+	err     error
+
+	// minimum average rate and maximum idle time, zero if not enforced
+	minRate int64
+	maxIdle time.Duration
+	grace   time.Duration
+
+	// bytes read and time spent waiting for them
+	n      int64
+	waited time.Duration
+}
+
+// newContextReader returns a contextReader for r or nil if neither ctx can be
+// done nor opts enforces a transfer rate.
+func newContextReader(ctx context.Context, r io.Reader, opts ParseOptions) *contextReader {
+	if ctx.Done() == nil && opts.MinBytesPerSecond <= 0 && opts.MaxIdleTime <= 0 {
+		return nil
+	}
+	grace := opts.MinRateGracePeriod
+	if grace == 0 {
+		grace = DefaultMinRateGracePeriod
+	}
+	return &contextReader{
+		ctx:     ctx,
+		r:       r,
+		results: make(chan readResult, 1),
+		minRate: opts.MinBytesPerSecond,
+		maxIdle: opts.MaxIdleTime,
+		grace:   grace,
+	}
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return 0, err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if cap(c.buf) < len(p) {
+		c.buf = make([]byte, len(p))
+	}
+	buf := c.buf[:len(p)]
+	go func() {
+		n, err := c.r.Read(buf)
+		c.results <- readResult{n, err}
+	}()
+
+	start := time.Now()
+	timeout, idle := c.timeout()
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	select {
+	case res := <-c.results:
+		c.n += int64(res.n)
+		c.waited += time.Since(start)
+		return copy(p, buf[:res.n]), res.err
+	case <-c.ctx.Done():
+		c.err = c.ctx.Err()
+	case <-expired:
+		c.waited += time.Since(start)
+		c.err = &SlowUploadError{BytesRead: c.n, Elapsed: c.waited, Idle: idle}
+	}
+	return 0, c.err
+}
+
+// timeout returns how long the next read may block, zero if it may block
+// forever. idle reports whether the timeout is caused by MaxIdleTime rather
+// than MinBytesPerSecond.
+func (c *contextReader) timeout() (timeout time.Duration, idle bool) {
+	if c.maxIdle > 0 {
+		timeout, idle = c.maxIdle, true
+	}
+	if c.minRate > 0 {
+		// time until the average rate including the next byte drops below
+		// minRate, only the time spent waiting for the body counts
+		allowed := c.grace + time.Duration(float64(c.n+1)/float64(c.minRate)*float64(time.Second))
+		rate := allowed - c.waited
+		if rate <= 0 {
+			rate = time.Nanosecond
+		}
+		if timeout == 0 || rate < timeout {
+			timeout, idle = rate, false
+		}
+	}
+	return timeout, idle
+}
+
+// abortError returns the *SlowUploadError which aborted parsing, or err
+// otherwise. c may be nil.
+func (c *contextReader) abortError(err error) error {
+	var se *SlowUploadError
+	if c != nil && errors.As(c.err, &se) {
+		return se
+	}
+	return err
+}
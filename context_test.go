@@ -0,0 +1,148 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testRequestStalled returns a request whose body stops shortly before its
+// end and blocks until the test finishes.
+func testRequestStalled(t *testing.T) *http.Request {
+	t.Helper()
+	r, boundary := testRequestWithMultipartForm(t)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr, pw := io.Pipe()
+	go pw.Write(body[:len(body)-1024])
+	t.Cleanup(func() { pw.Close() })
+
+	r, err = http.NewRequest("POST", "/", pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Add("Content-Type", "multipart/form-data; boundary="+boundary)
+	return r
+}
+
+func TestParseContext(t *testing.T) {
+	fd, err := ParseContext(context.Background(), testRequestValidContentType(t), ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fd.GetFile("attachment")); got != 2 {
+		t.Errorf("Invalid number of files: expected: 2, got: %d", got)
+	}
+
+	store := NewMemoryStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err = ParseContext(ctx, testRequestStalled(t), ParseOptions{MaxMemory: -1, FileStore: store})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Invalid error: expected: %v, got: %v", context.Canceled, err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Files not removed: got: %d", store.Len())
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = ParseContext(ctx, testRequestStalled(t), ParseOptions{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Invalid error: expected: %v, got: %v", context.DeadlineExceeded, err)
+	}
+
+	_, err = ParseContext(ctx, testRequestValidContentType(t), ParseOptions{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Invalid error: expected: %v, got: %v", context.DeadlineExceeded, err)
+	}
+
+	_, err = ParseContext(context.Background(), testRequestInvalidContentType(t), ParseOptions{})
+	if err != ErrNotMultipartFormData {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrNotMultipartFormData, err)
+	}
+}
+
+// trickleReader returns one byte per read after the given delay.
+type trickleReader struct {
+	r     io.Reader
+	delay time.Duration
+}
+
+func (t *trickleReader) Read(p []byte) (int, error) {
+	time.Sleep(t.delay)
+	if len(p) > 1 {
+		p = p[:1]
+	}
+	return t.r.Read(p)
+}
+
+func TestParseWithOptionsSlowUpload(t *testing.T) {
+	opts := ParseOptions{
+		MinBytesPerSecond:  1 << 10,
+		MinRateGracePeriod: 50 * time.Millisecond,
+		MaxIdleTime:        time.Second,
+	}
+	if _, err := ParseWithOptions(testRequestValidContentType(t), opts); err != nil {
+		t.Fatal(err)
+	}
+
+	r := testRequestValidContentType(t)
+	r.Body = io.NopCloser(&trickleReader{r: r.Body, delay: time.Millisecond})
+	_, err := ParseWithOptions(r, opts)
+	var se *SlowUploadError
+	if !errors.As(err, &se) {
+		t.Fatalf("Invalid error: expected: *SlowUploadError, got: %v", err)
+	}
+	if se.Idle || !se.Timeout() || se.BytesRead == 0 {
+		t.Errorf("Invalid SlowUploadError: got: %+v", se)
+	}
+
+	store := NewMemoryStore()
+	opts = ParseOptions{MaxIdleTime: 50 * time.Millisecond, MaxMemory: -1, FileStore: store}
+	_, err = ParseWithOptions(testRequestStalled(t), opts)
+	if !errors.As(err, &se) || !se.Idle {
+		t.Errorf("Invalid error: expected: idle *SlowUploadError, got: %v", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Files not removed: got: %d", store.Len())
+	}
+
+	r, boundary := testRequestWithMultipartForm(t)
+	_, err = ParseReader(&trickleReader{r: r.Body, delay: 100 * time.Millisecond}, boundary, ParseOptions{MaxIdleTime: 50 * time.Millisecond})
+	if !errors.As(err, &se) || !se.Idle {
+		t.Errorf("Invalid error: expected: idle *SlowUploadError, got: %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import "mime/multipart"
+
+// DuplicatePolicy defines how a key sent multiple times is handled.
+type DuplicatePolicy int
+
+const (
+	// AllowDuplicates keeps all elements of a key.
+	AllowDuplicates DuplicatePolicy = iota
+
+	// KeepFirst keeps only the first element of a key.
+	KeepFirst
+
+	// KeepLast keeps only the last element of a key.
+	KeepLast
+
+	// RejectDuplicates keeps all elements of a key and adds a validation error
+	// if a key has more than one element.
+	RejectDuplicates
+)
+
+// Duplicates applies policy to the key of the validation. KeepFirst and
+// KeepLast drop all other elements, so subsequent validations like Match see
+// only the kept element. RejectDuplicates adds a validation error if the key
+// has more than one element.
+func (v *Validation) Duplicates(policy DuplicatePolicy) *Validation {
+	if v.isFile {
+		headers := v.data.File[v.key]
+		if len(headers) > 1 && policy == RejectDuplicates {
+			v.addDuplicateError(len(headers))
+		}
+		if i, ok := keptElement(len(headers), policy); ok {
+			v.data.discardFiles(headers[:i])
+			v.data.discardFiles(headers[i+1:])
+			v.data.File[v.key] = headers[i : i+1]
+		}
+		return v
+	}
+
+	values := v.data.Value[v.key]
+	if len(values) > 1 && policy == RejectDuplicates {
+		v.addDuplicateError(len(values))
+	}
+	if i, ok := keptElement(len(values), policy); ok {
+		v.data.Value[v.key] = values[i : i+1]
+	}
+	return v
+}
+
+// keptElement returns the index of the only element of n elements kept by
+// policy. ok is false if all elements are kept.
+func keptElement(n int, policy DuplicatePolicy) (i int, ok bool) {
+	if n <= 1 {
+		return 0, false
+	}
+	switch policy {
+	case KeepFirst:
+		return 0, true
+	case KeepLast:
+		return n - 1, true
+	}
+	return 0, false
+}
+
+// discardFiles records files dropped from FormData.File, so RemoveAll still
+// removes their content.
+func (fd *FormData) discardFiles(headers []*multipart.FileHeader) {
+	for _, fh := range headers {
+		if _, ok := fd.files[fh]; !ok {
+			fd.discarded = append(fd.discarded, fh)
+		}
+	}
+}
+
+// applyDuplicatePolicies applies the duplicate policies of opts to all keys in
+// sorted order, values first, so the order of the errors is stable.
+func (fd *FormData) applyDuplicatePolicies(opts ParseOptions) {
+	policy := func(key string) DuplicatePolicy {
+		if p, ok := opts.KeyDuplicates[key]; ok {
+			return p
+		}
+		return opts.Duplicates
+	}
+	for _, key := range sortedKeys(fd.Value) {
+		if p := policy(key); p != AllowDuplicates {
+			fd.Validate(key).Duplicates(p)
+		}
+	}
+	for _, key := range sortedFileKeys(fd.File) {
+		if p := policy(key); p != AllowDuplicates {
+			fd.ValidateFile(key).Duplicates(p)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"mime/multipart"
+	"reflect"
+	"testing"
+)
+
+func TestDuplicates(t *testing.T) {
+	testcases := []struct {
+		policy   DuplicatePolicy
+		expected []string
+		errors   int
+	}{
+		{AllowDuplicates, []string{"user", "admin"}, 0},
+		{KeepFirst, []string{"user"}, 0},
+		{KeepLast, []string{"admin"}, 0},
+		{RejectDuplicates, []string{"user", "admin"}, 1},
+	}
+
+	for _, testcase := range testcases {
+		fd := emptyFormData()
+		fd.Value["role"] = []string{"user", "admin"}
+		fd.Value["name"] = []string{"neox5"}
+
+		fd.Validate("role").Duplicates(testcase.policy)
+		fd.Validate("name").Duplicates(testcase.policy)
+		if got := fd.Get("role"); !reflect.DeepEqual([]string(got), testcase.expected) {
+			t.Errorf("Invalid values for %d: expected: %v, got: %v", testcase.policy, testcase.expected, got)
+		}
+		if got := len(fd.Errors()); got != testcase.errors {
+			t.Errorf("Invalid number of errors for %d: expected: %d, got: %d", testcase.policy, testcase.errors, got)
+		}
+	}
+}
+
+func TestParseWithOptionsDuplicates(t *testing.T) {
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{
+		Duplicates:    KeepLast,
+		KeyDuplicates: map[string]DuplicatePolicy{"to": AllowDuplicates},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fd.Get("to")); got != 2 {
+		t.Errorf("Invalid number of values: expected: 2, got: %d", got)
+	}
+	files := fd.GetFile("attachment")
+	if len(files) != 1 || files.First().Filename != "test_binary.bin" {
+		t.Errorf("Invalid files: expected: [test_binary.bin], got: %d files", len(files))
+	}
+	if fd.HasErrors() {
+		t.Errorf("Unexpected errors: %v", fd.Errors())
+	}
+
+	fd, err = ParseWithOptions(testRequestValidContentType(t), ParseOptions{Duplicates: RejectDuplicates})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fd.Errors()); got != 2 {
+		t.Errorf("Invalid number of errors: expected: 2, got: %d", got)
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, key := range []string{"c", "a", "e", "b", "d", "c", "a", "e", "b", "d"} {
+		w.WriteField(key, "value")
+	}
+	w.Close()
+	for i := 0; i < 10; i++ {
+		fd, err = ParseReader(bytes.NewReader(body.Bytes()), w.Boundary(), ParseOptions{Duplicates: RejectDuplicates})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, ve := range fd.ValidationErrors() {
+			keys = append(keys, ve.Key())
+		}
+		if expected := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(keys, expected) {
+			t.Fatalf("Invalid order of errors: expected: %v, got: %v", expected, keys)
+		}
+	}
+
+	fd, err = ParseMax(testRequestValidContentType(t), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.ValidateFile("attachment").Duplicates(KeepFirst)
+	if got := len(fd.GetFile("attachment")); got != 1 {
+		t.Errorf("Invalid number of files: expected: 1, got: %d", got)
+	}
+	if len(fd.discarded) != 1 {
+		t.Errorf("Dropped file not recorded: got: %d", len(fd.discarded))
+	}
+	if err := fd.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+}
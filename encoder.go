@@ -0,0 +1,305 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dispositionEscaper escapes keys and filenames of the Content-Disposition
+// header like browsers do, see whatwgUnescaper. Escaping CR and LF prevents
+// injecting headers with names like "a\r\nX-Evil: 1".
+var dispositionEscaper = strings.NewReplacer("\\", "\\\\", `"`, "%22", "\r", "%0D", "\n", "%0A")
+
+// Encoder writes a multipart/form-data body. An Encoder writes a single body,
+// Encode and EncodeStruct write all parts and the closing boundary.
+type Encoder struct {
+	w *multipart.Writer
+}
+
+// NewEncoder returns an Encoder writing to w with a random boundary.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: multipart.NewWriter(w)}
+}
+
+// SetBoundary overrides the random boundary of the Encoder, see
+// multipart.Writer.SetBoundary. SetBoundary must be called before encoding.
+func (e *Encoder) SetBoundary(boundary string) error {
+	return e.w.SetBoundary(boundary)
+}
+
+// Boundary returns the boundary of the Encoder.
+func (e *Encoder) Boundary() string {
+	return e.w.Boundary()
+}
+
+// FormDataContentType returns the Content-Type of the encoded body.
+func (e *Encoder) FormDataContentType() string {
+	return e.w.FormDataContentType()
+}
+
+// DeterministicBoundary returns a boundary derived from seed, which makes
+// encoded bodies reproducible, e.g. for signing requests or golden files.
+func DeterministicBoundary(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return "formdata-" + hex.EncodeToString(sum[:20])
+}
+
+// Encode writes the values and files of fd. Parts are written in the order of
+// fd.Parts, values and files without part, e.g. of FormData parsed by
+// ParseMax, follow ordered by key. The content of files is streamed from
+// File.Open. Quotes, CR and LF in keys and filenames are escaped as %22, %0D
+// and %0A like browsers do.
+func (e *Encoder) Encode(fd *FormData) error {
+	valueIndex := make(map[string]int)
+	fileIndex := make(map[string]int)
+	for _, part := range fd.Parts() {
+		if part.IsFile {
+			if i := fileIndex[part.Name]; i < len(fd.File[part.Name]) {
+				fileIndex[part.Name]++
+				if err := e.writeFile(part.Name, fd.file(fd.File[part.Name][i])); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if i := valueIndex[part.Name]; i < len(fd.Value[part.Name]) {
+			valueIndex[part.Name]++
+			if err := e.writeField(part.Name, fd.Value[part.Name][i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(fd.Value) {
+		for _, value := range fd.Value[key][valueIndex[key]:] {
+			if err := e.writeField(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range sortedFileKeys(fd.File) {
+		for _, fh := range fd.File[key][fileIndex[key]:] {
+			if err := e.writeFile(key, fd.file(fh)); err != nil {
+				return err
+			}
+		}
+	}
+	return e.w.Close()
+}
+
+// EncodeStruct writes the fields of the struct v points to, using the same
+// `form` and `layout` tags and field types as FormData.Bind. Nil pointers are
+// skipped, slices are written as multiple parts of the same key.
+func (e *Encoder) EncodeStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("encoding requires a struct, got: %T", v)
+	}
+	if err := e.encodeStruct(rv); err != nil {
+		return err
+	}
+	return e.w.Close()
+}
+
+func (e *Encoder) encodeStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			if err := e.encodeStruct(v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		key := sf.Tag.Get("form")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = sf.Name
+		}
+		if err := e.encodeField(key, sf, v.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Encoder) encodeField(key string, sf reflect.StructField, fv reflect.Value) error {
+	switch fv.Type() {
+	case formDataFileType:
+		for _, f := range fv.Interface().(FormDataFile) {
+			if err := e.writeFile(key, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case fileType:
+		if fv.IsNil() {
+			return nil
+		}
+		return e.writeFile(key, fv.Interface().(*File))
+	case fileHeaderType:
+		if fv.IsNil() {
+			return nil
+		}
+		return e.writeFile(key, &File{FileHeader: fv.Interface().(*multipart.FileHeader)})
+	case fileHeadersType:
+		for _, fh := range fv.Interface().([]*multipart.FileHeader) {
+			if err := e.writeFile(key, &File{FileHeader: fh}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t := fv.Type()
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !bindable(t) {
+		return fmt.Errorf("encoding does not support field %s of type %s", sf.Name, fv.Type())
+	}
+	layout := sf.Tag.Get("layout")
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	switch fv.Kind() {
+	case reflect.Slice:
+		for i := 0; i < fv.Len(); i++ {
+			if err := e.writeField(key, formatValue(fv.Index(i), layout)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Ptr:
+		if fv.IsNil() {
+			return nil
+		}
+		fv = fv.Elem()
+	}
+	return e.writeField(key, formatValue(fv, layout))
+}
+
+// writeField writes a value part. Unlike multipart.Writer.WriteField, the key
+// is escaped with dispositionEscaper.
+func (e *Encoder) writeField(key, value string) error {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, dispositionEscaper.Replace(key)))
+	w, err := e.w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, value)
+	return err
+}
+
+// writeFile writes a file part streaming the content of f.
+func (e *Encoder) writeFile(key string, f *File) error {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+		dispositionEscaper.Replace(key), dispositionEscaper.Replace(f.Filename)))
+	contentType := f.ContentType()
+	if contentType == "" {
+		contentType = octetStream
+	}
+	header.Set("Content-Type", contentType)
+
+	w, err := e.w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// formatValue formats v as the counterpart of setValue.
+func formatValue(v reflect.Value, layout string) string {
+	switch v.Type() {
+	case timeType:
+		return v.Interface().(time.Time).Format(layout)
+	case durationType:
+		return time.Duration(v.Int()).String()
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	}
+	return v.String()
+}
+
+// NewRequest returns an http.Request with fd encoded as multipart/form-data
+// body. The body is encoded while it is sent, files are streamed through an
+// io.Pipe instead of being buffered. Encoding errors are returned by the
+// http.Client sending the request. The body of the request must be read or
+// closed, otherwise encoding blocks forever.
+func NewRequest(ctx context.Context, method, url string, fd *FormData) (*http.Request, error) {
+	pr, pw := io.Pipe()
+	e := NewEncoder(pw)
+	r, err := http.NewRequestWithContext(ctx, method, url, pr)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", e.FormDataContentType())
+
+	go func() {
+		pw.CloseWithError(e.Encode(fd))
+	}()
+	return r, nil
+}
@@ -0,0 +1,205 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncode(t *testing.T) {
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := func() ([]byte, *Encoder) {
+		var body bytes.Buffer
+		e := NewEncoder(&body)
+		if err := e.SetBoundary(DeterministicBoundary("test")); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Encode(fd); err != nil {
+			t.Fatal(err)
+		}
+		return body.Bytes(), e
+	}
+	body, e := encode()
+	if again, _ := encode(); !bytes.Equal(body, again) {
+		t.Errorf("Encoding with deterministic boundary differs")
+	}
+
+	decoded, err := ParseBytes(e.FormDataContentType(), body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Value, fd.Value) {
+		t.Errorf("Invalid values: expected: %v, got: %v", fd.Value, decoded.Value)
+	}
+	for i, part := range fd.Parts() {
+		got := decoded.Parts()[i]
+		if got.Name != part.Name || got.Filename != part.Filename {
+			t.Errorf("Invalid part %d: expected: %s %s, got: %s %s", i, part.Name, part.Filename, got.Name, got.Filename)
+		}
+	}
+	files := decoded.GetFile("attachment")
+	if len(files) != 2 {
+		t.Fatalf("Invalid number of files: expected: 2, got: %d", len(files))
+	}
+	testFileContent(t, files.First(), "This is my second test file")
+	r, err := fd.GetFile("attachment").At(1).Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary, _ := io.ReadAll(r)
+	testFileContent(t, files.At(1), string(binary))
+
+	// values without parts, e.g. after ParseMax, are ordered by key
+	fd = emptyFormData()
+	fd.Value["b"] = []string{"2"}
+	fd.Value["a"] = []string{"1", "1"}
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(fd); err != nil {
+		t.Fatal(err)
+	}
+	if a, b := strings.Index(buf.String(), `name="a"`), strings.Index(buf.String(), `name="b"`); a < 0 || a > b {
+		t.Errorf("Invalid order of values: got: %s", buf.String())
+	}
+}
+
+func TestEncodeEscaping(t *testing.T) {
+	const evil = "a\r\nX-Evil: 1.txt"
+	body := "--b\r\n" +
+		`Content-Disposition: form-data; name="f"; filename="a%0D%0AX-Evil: 1.txt"` + "\r\n\r\n" +
+		"content\r\n--b--\r\n"
+	fd, err := ParseBytes("multipart/form-data; boundary=b", []byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fd.GetFile("f").First().Filename; got != evil {
+		t.Fatalf("Invalid parsed filename: expected: %q, got: %q", evil, got)
+	}
+	fd.Value["v\r\nX-Evil: 1"] = []string{"value"}
+
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	if err := e.Encode(fd); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "\r\nX-Evil") {
+		t.Errorf("Header injected: got: %s", buf.String())
+	}
+
+	decoded, err := ParseBytes(e.FormDataContentType(), buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := decoded.GetFile("f").First()
+	if f == nil {
+		t.Fatalf("File missing after round trip: got: %v", decoded.File)
+	}
+	if f.Filename != evil {
+		t.Errorf("Invalid filename after round trip: expected: %q, got: %q", evil, f.Filename)
+	}
+	testFileContent(t, f, "content")
+	if got := decoded.Get("v%0D%0AX-Evil: 1").First(); got != "value" {
+		t.Errorf("Invalid escaped value: expected: %q, got: %q", "value", got)
+	}
+}
+
+func TestEncodeStruct(t *testing.T) {
+	type mail struct {
+		From    string        `form:"from"`
+		To      []string      `form:"to"`
+		Retries int           `form:"retries"`
+		Urgent  bool          `form:"urgent"`
+		Delay   time.Duration `form:"delay"`
+		SendAt  *time.Time    `form:"send_at" layout:"2006-01-02"`
+		CC      *string       `form:"cc"`
+	}
+	sendAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	src := mail{
+		From:    "noreply@example.com",
+		To:      []string{"a@example.com", "b@example.com"},
+		Retries: 3,
+		Urgent:  true,
+		Delay:   time.Minute,
+		SendAt:  &sendAt,
+	}
+
+	var body bytes.Buffer
+	e := NewEncoder(&body)
+	if err := e.EncodeStruct(&src); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := ParseBytes(e.FormDataContentType(), body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fd.Exists("cc") {
+		t.Errorf("Nil pointer encoded")
+	}
+
+	var dst mail
+	if err := Unmarshal(fd, &dst); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(src, dst) {
+		t.Errorf("Invalid struct: expected: %+v, got: %+v", src, dst)
+	}
+
+	if err := NewEncoder(io.Discard).EncodeStruct("mail"); err == nil {
+		t.Errorf("Encoding a string succeeded")
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewRequest(context.Background(), "POST", "http://example.com/mail", fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ParseWithOptions(r, ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Value, fd.Value) {
+		t.Errorf("Invalid values: expected: %v, got: %v", fd.Value, decoded.Value)
+	}
+	if got := len(decoded.GetFile("attachment")); got != 2 {
+		t.Errorf("Invalid number of files: expected: 2, got: %d", got)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"io"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+)
+
+const (
+	// DefaultMaxDecompressedBytes is the maximum size of a decompressed part
+	// if ParseOptions.MaxDecompressedBytes is zero.
+	DefaultMaxDecompressedBytes int64 = 32 << 20 // 32 MiB
+)
+
+// partEncodings returns the normalized Content-Transfer-Encoding and
+// Content-Encoding of a part header. Encodings which leave the content as it
+// is are returned as empty strings.
+func partEncodings(header textproto.MIMEHeader) (transfer, content string) {
+	transfer = strings.ToLower(strings.TrimSpace(header.Get("Content-Transfer-Encoding")))
+	switch transfer {
+	case "7bit", "8bit", "binary":
+		transfer = ""
+	}
+	content = strings.ToLower(strings.TrimSpace(header.Get("Content-Encoding")))
+	if content == "identity" {
+		content = ""
+	}
+	return transfer, content
+}
+
+// decodePart returns a reader decoding the content of r according to the
+// given encodings of partEncodings. Decompressed content is limited to
+// maxBytes if maxBytes is positive.
+func decodePart(r io.Reader, transfer, content string, maxBytes int64) (io.Reader, error) {
+	switch transfer {
+	case "":
+	case "base64":
+		// the decoder skips line breaks
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	default:
+		return nil, ErrUnsupportedEncoding
+	}
+
+	switch content {
+	case "":
+		return r, nil
+	case "gzip", "x-gzip":
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		r = zr
+	case "deflate":
+		zr, err := newDeflateReader(r)
+		if err != nil {
+			return nil, err
+		}
+		r = zr
+	default:
+		return nil, ErrUnsupportedEncoding
+	}
+	if maxBytes > 0 {
+		r = newLimitReader(r, maxBytes, ErrDecompressedTooLarge)
+	}
+	return r, nil
+}
+
+// newDeflateReader decompresses r in zlib format, as defined for the deflate
+// Content-Encoding, or raw deflate format, as sent by some clients.
+func newDeflateReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
@@ -0,0 +1,226 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// testEncodedBody returns a multipart/form-data body with a single part sent
+// with the given encodings, content is encoded accordingly.
+func testEncodedBody(t *testing.T, filename, transfer, content string, data []byte) ([]byte, string) {
+	t.Helper()
+
+	var encoded bytes.Buffer
+	var w io.WriteCloser
+	switch content {
+	case "gzip":
+		w = gzip.NewWriter(&encoded)
+	case "deflate":
+		w = zlib.NewWriter(&encoded)
+	case "raw-deflate":
+		w, _ = flate.NewWriter(&encoded, flate.DefaultCompression)
+		content = "deflate"
+	default:
+		w = nopWriteCloser{&encoded}
+	}
+	w.Write(data)
+	w.Close()
+	data = encoded.Bytes()
+
+	encoded = bytes.Buffer{}
+	switch transfer {
+	case "base64":
+		w = base64.NewEncoder(base64.StdEncoding, &encoded)
+	case "quoted-printable":
+		w = quotedprintable.NewWriter(&encoded)
+	default:
+		w = nopWriteCloser{&encoded}
+	}
+	w.Write(data)
+	w.Close()
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	header := make(textproto.MIMEHeader)
+	disposition := `form-data; name="field"`
+	if filename != "" {
+		disposition += `; filename="` + filename + `"`
+	}
+	header.Set("Content-Disposition", disposition)
+	if transfer != "" {
+		header.Set("Content-Transfer-Encoding", transfer)
+	}
+	if content != "" {
+		header.Set("Content-Encoding", content)
+	}
+	pw, err := mw.CreatePart(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw.Write(encoded.Bytes())
+	mw.Close()
+	return body.Bytes(), mw.Boundary()
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestParseEncodings(t *testing.T) {
+	const text = "Grüße aus Wien = schöne Grüße\r\n"
+	testcases := []struct {
+		filename string
+		transfer string
+		content  string
+	}{
+		{"", "", ""},
+		{"", "base64", ""},
+		{"", "quoted-printable", ""},
+		{"", "", "gzip"},
+		{"", "base64", "gzip"},
+		{"data.txt", "base64", ""},
+		{"data.txt", "quoted-printable", ""},
+		{"data.txt", "", "gzip"},
+		{"data.txt", "", "deflate"},
+		{"data.txt", "", "raw-deflate"},
+		{"data.txt", "base64", "deflate"},
+	}
+
+	for _, testcase := range testcases {
+		body, boundary := testEncodedBody(t, testcase.filename, testcase.transfer, testcase.content, []byte(text))
+		fd, err := ParseReader(bytes.NewReader(body), boundary, ParseOptions{})
+		if err != nil {
+			t.Errorf("Parsing %+v failed: %v", testcase, err)
+			continue
+		}
+
+		if testcase.filename == "" {
+			if got := fd.Get("field").First(); got != text {
+				t.Errorf("Invalid value for %+v: expected: %q, got: %q", testcase, text, got)
+			}
+		} else {
+			testFileContent(t, fd.GetFile("field").First(), text)
+		}
+
+		content := strings.TrimPrefix(testcase.content, "raw-")
+		part := fd.Parts()[0]
+		if part.TransferEncoding != testcase.transfer || part.ContentEncoding != content {
+			t.Errorf("Invalid encodings for %+v: got: %q, %q", testcase, part.TransferEncoding, part.ContentEncoding)
+		}
+	}
+}
+
+func TestParseStreamEncodings(t *testing.T) {
+	const text = "Grüße aus Wien = schöne Grüße\r\n"
+	testcases := []struct {
+		filename string
+		transfer string
+		content  string
+	}{
+		{"", "quoted-printable", ""},
+		{"", "base64", "gzip"},
+		{"data.txt", "base64", ""},
+		{"data.txt", "quoted-printable", ""},
+		{"data.txt", "", "gzip"},
+		{"data.txt", "base64", "deflate"},
+	}
+
+	for _, testcase := range testcases {
+		body, boundary := testEncodedBody(t, testcase.filename, testcase.transfer, testcase.content, []byte(text))
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		r.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+
+		var got []byte
+		var part Part
+		fd, err := ParseStream(r, func(fd *FormData, p *StreamPart) error {
+			part = *p.Part
+			b, err := io.ReadAll(p)
+			got = b
+			return err
+		})
+		if err != nil {
+			t.Errorf("Parsing %+v failed: %v", testcase, err)
+			continue
+		}
+
+		if testcase.filename == "" {
+			got = []byte(fd.Get("field").First())
+		}
+		if string(got) != text {
+			t.Errorf("Invalid content for %+v: expected: %q, got: %q", testcase, text, got)
+		}
+		if part.TransferEncoding != testcase.transfer || part.ContentEncoding != testcase.content {
+			t.Errorf("Invalid encodings for %+v: got: %q, %q", testcase, part.TransferEncoding, part.ContentEncoding)
+		}
+	}
+}
+
+func TestParseEncodingsErrors(t *testing.T) {
+	bomb := bytes.Repeat([]byte{0}, 1<<20)
+	body, boundary := testEncodedBody(t, "bomb.bin", "", "gzip", bomb)
+	if len(body) > 4<<10 {
+		t.Fatalf("Compressed body too large: got: %d", len(body))
+	}
+	_, err := ParseReader(bytes.NewReader(body), boundary, ParseOptions{MaxDecompressedBytes: 64 << 10})
+	if !errors.Is(err, ErrDecompressedTooLarge) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrDecompressedTooLarge, err)
+	}
+	if _, err := ParseReader(bytes.NewReader(body), boundary, ParseOptions{MaxDecompressedBytes: -1}); err != nil {
+		t.Errorf("Unlimited decompression failed: %v", err)
+	}
+
+	body, boundary = testEncodedBody(t, "", "", "", []byte("value"))
+	body = bytes.Replace(body, []byte("Content-Disposition"), []byte("Content-Encoding: br\r\nContent-Disposition"), 1)
+	_, err = ParseReader(bytes.NewReader(body), boundary, ParseOptions{})
+	if !errors.Is(err, ErrUnsupportedEncoding) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrUnsupportedEncoding, err)
+	}
+
+	body, boundary = testEncodedBody(t, "", "base64", "", []byte("value"))
+	body = bytes.Replace(body, []byte("dmFsdWU="), []byte("dmFsdWU*"), 1)
+	_, err = ParseReader(bytes.NewReader(body), boundary, ParseOptions{})
+	var corrupt base64.CorruptInputError
+	if !errors.As(err, &corrupt) {
+		t.Errorf("Invalid error: expected: base64.CorruptInputError, got: %v", err)
+	}
+}
@@ -0,0 +1,268 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ordered is satisfied by the types supporting the < operator.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Field describes a single value of the form-data with the type T. A Field
+// is declared once and drives validation, parsing and the default value of
+// its key:
+//
+//	age := formdata.IntField("age").Required().Min(18)
+//
+//	age.Validate(fd)
+//	if fd.HasErrors() {
+//		// ...handle bad request
+//	}
+//	years := age.Get(fd)
+//
+// Only the first element of the value is used. A missing key and an empty
+// first element are treated the same, except for fields of type string.
+type Field[T any] struct {
+	key        string
+	typeName   string
+	parse      func(s string) (T, error)
+	compare    func(a, b T) int
+	required   bool
+	def        T
+	allowEmpty bool
+	checks     []func(v *Validation, value T)
+}
+
+// NewField returns a Field for key, whose value is converted with parse.
+// Min and Max are not supported by fields created with NewField.
+func NewField[T any](key string, parse func(s string) (T, error)) *Field[T] {
+	var zero T
+	return &Field[T]{
+		key:      key,
+		typeName: fmt.Sprintf("%T", zero),
+		parse:    parse,
+	}
+}
+
+// newOrderedField returns a Field supporting Min and Max.
+func newOrderedField[T ordered](key string, parse func(s string) (T, error)) *Field[T] {
+	f := NewField(key, parse)
+	f.compare = compareOrdered[T]
+	return f
+}
+
+// StringField returns a Field for the string value of key. An empty string
+// is a valid value.
+func StringField(key string) *Field[string] {
+	f := newOrderedField(key, func(s string) (string, error) { return s, nil })
+	f.allowEmpty = true
+	return f
+}
+
+// IntField returns a Field for the int value of key.
+func IntField(key string) *Field[int] {
+	return newOrderedField(key, strconv.Atoi)
+}
+
+// Int64Field returns a Field for the int64 value of key.
+func Int64Field(key string) *Field[int64] {
+	return newOrderedField(key, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	})
+}
+
+// UintField returns a Field for the uint value of key.
+func UintField(key string) *Field[uint] {
+	return newOrderedField(key, func(s string) (uint, error) {
+		n, err := strconv.ParseUint(s, 10, 0)
+		return uint(n), err
+	})
+}
+
+// Float64Field returns a Field for the float64 value of key.
+func Float64Field(key string) *Field[float64] {
+	return newOrderedField(key, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// BoolField returns a Field for the bool value of key, see
+// FormDataValue.Bool.
+func BoolField(key string) *Field[bool] {
+	return NewField(key, parseBool)
+}
+
+// TimeField returns a Field for the time value of key in the given layout,
+// see time.Parse.
+func TimeField(key, layout string) *Field[time.Time] {
+	f := NewField(key, func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	})
+	f.compare = func(a, b time.Time) int {
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	}
+	return f
+}
+
+// DurationField returns a Field for the time.Duration value of key.
+func DurationField(key string) *Field[time.Duration] {
+	return newOrderedField(key, time.ParseDuration)
+}
+
+// Key returns the form-data key of the field.
+func (f *Field[T]) Key() string {
+	return f.key
+}
+
+// Required adds a validation error if the value is missing.
+func (f *Field[T]) Required() *Field[T] {
+	f.required = true
+	return f
+}
+
+// Default sets the value Get returns if the value is missing or invalid.
+func (f *Field[T]) Default(def T) *Field[T] {
+	f.def = def
+	return f
+}
+
+// Min adds a validation error if the value is less than min. Min panics if
+// T is not ordered, e.g. for fields created with NewField.
+func (f *Field[T]) Min(min T) *Field[T] {
+	if f.compare == nil {
+		panic("Min is not supported for field type " + f.typeName + "!")
+	}
+	f.checks = append(f.checks, func(v *Validation, value T) {
+		if f.compare(value, min) < 0 {
+			v.addError(v.key, CodeRange, fmt.Sprintf("must be at least %v", min))
+		}
+	})
+	return f
+}
+
+// Max adds a validation error if the value is greater than max. Max panics
+// if T is not ordered, e.g. for fields created with NewField.
+func (f *Field[T]) Max(max T) *Field[T] {
+	if f.compare == nil {
+		panic("Max is not supported for field type " + f.typeName + "!")
+	}
+	f.checks = append(f.checks, func(v *Validation, value T) {
+		if f.compare(value, max) > 0 {
+			v.addError(v.key, CodeRange, fmt.Sprintf("must be at most %v", max))
+		}
+	})
+	return f
+}
+
+// Check adds a validation error with the message of the error returned by
+// check, if any.
+func (f *Field[T]) Check(check func(value T) error) *Field[T] {
+	f.checks = append(f.checks, func(v *Validation, value T) {
+		if err := check(value); err != nil {
+			v.addError(v.key, CodeInvalid, err.Error())
+		}
+	})
+	return f
+}
+
+// Validate validates the value of the field in fd and adds the errors to
+// fd. The returned Validation allows chaining further validations.
+func (f *Field[T]) Validate(fd *FormData) *Validation {
+	v := fd.Validate(f.key)
+
+	s, ok := f.first(fd)
+	if !ok {
+		if f.required {
+			v.addRequiredError(f.key)
+		}
+		return v
+	}
+
+	value, err := f.parse(s)
+	if err != nil {
+		v.addConversionError(0, f.typeName)
+		return v
+	}
+	for _, check := range f.checks {
+		check(v, value)
+	}
+	return v
+}
+
+// Lookup returns the value of the field in fd. The error is ErrNoValue if the
+// value is missing, or the conversion error if it is invalid. Lookup does not
+// run the validations of the field.
+func (f *Field[T]) Lookup(fd *FormData) (T, error) {
+	s, ok := f.first(fd)
+	if !ok {
+		var zero T
+		return zero, ErrNoValue
+	}
+	return f.parse(s)
+}
+
+// Get returns the value of the field in fd, or its default if the value is
+// missing or invalid. Get does not run the validations of the field.
+func (f *Field[T]) Get(fd *FormData) T {
+	value, err := f.Lookup(fd)
+	if err != nil {
+		return f.def
+	}
+	return value
+}
+
+// first returns the first element of the value and whether it is present.
+func (f *Field[T]) first(fd *FormData) (string, bool) {
+	values := fd.Get(f.key)
+	if len(values) == 0 || (values[0] == "" && !f.allowEmpty) {
+		return "", false
+	}
+	return values[0], true
+}
+
+func compareOrdered[T ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
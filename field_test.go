@@ -0,0 +1,133 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFieldValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		values   []string
+		validate func(fd *FormData)
+		codes    []ValidationCode
+	}{
+		{"valid", []string{"21"}, func(fd *FormData) { IntField("key").Required().Min(18).Validate(fd) }, nil},
+		{"missing", nil, func(fd *FormData) { IntField("key").Required().Validate(fd) }, []ValidationCode{CodeRequired}},
+		{"empty", []string{""}, func(fd *FormData) { IntField("key").Required().Validate(fd) }, []ValidationCode{CodeRequired}},
+		{"optional", nil, func(fd *FormData) { IntField("key").Min(18).Validate(fd) }, nil},
+		{"invalid", []string{"x"}, func(fd *FormData) { IntField("key").Min(18).Validate(fd) }, []ValidationCode{CodeConversion}},
+		{"min", []string{"17"}, func(fd *FormData) { IntField("key").Min(18).Validate(fd) }, []ValidationCode{CodeRange}},
+		{"max", []string{"1.5"}, func(fd *FormData) { Float64Field("key").Min(0).Max(1).Validate(fd) }, []ValidationCode{CodeRange}},
+		{"empty string", []string{""}, func(fd *FormData) { StringField("key").Required().Validate(fd) }, nil},
+		{"string max", []string{"b"}, func(fd *FormData) { StringField("key").Max("a").Validate(fd) }, []ValidationCode{CodeRange}},
+		{"time min", []string{"2026-10-16"}, func(fd *FormData) {
+			TimeField("key", "2006-01-02").Min(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)).Validate(fd)
+		}, []ValidationCode{CodeRange}},
+		{"duration", []string{"90s"}, func(fd *FormData) { DurationField("key").Max(time.Minute).Validate(fd) }, []ValidationCode{CodeRange}},
+		{"check", []string{"on"}, func(fd *FormData) {
+			BoolField("key").Check(func(b bool) error {
+				if b {
+					return errors.New("must not be set")
+				}
+				return nil
+			}).Validate(fd)
+		}, []ValidationCode{CodeInvalid}},
+		{"chained", []string{"7"}, func(fd *FormData) { UintField("key").Validate(fd).HasN(2) }, []ValidationCode{CodeCount}},
+	}
+
+	for _, testcase := range testcases {
+		fd := emptyFormData()
+		if testcase.values != nil {
+			fd.Value["key"] = testcase.values
+		}
+
+		testcase.validate(fd)
+		errs := fd.ValidationErrors()
+		if len(errs) != len(testcase.codes) {
+			t.Errorf("%s returned invalid errors: expected: %v, got: %s", testcase.name, testcase.codes, strings.Join(fd.Errors(), " "))
+			continue
+		}
+		for i, err := range errs {
+			if err.Code() != testcase.codes[i] {
+				t.Errorf("%s returned invalid code: expected: %v, got: %v", testcase.name, testcase.codes[i], err.Code())
+			}
+		}
+	}
+}
+
+func TestFieldGet(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["age"] = []string{"42"}
+	fd.Value["invalid"] = []string{"x"}
+	fd.Value["name"] = []string{""}
+
+	age := IntField("age").Default(18)
+	if got := age.Get(fd); got != 42 {
+		t.Errorf("Get returned invalid value: expected: %d, got: %d", 42, got)
+	}
+	if got := IntField("invalid").Default(18).Get(fd); got != 18 {
+		t.Errorf("Get of invalid value returned invalid default: expected: %d, got: %d", 18, got)
+	}
+	if got := Int64Field("missing").Default(5).Get(fd); got != 5 {
+		t.Errorf("Get of missing value returned invalid default: expected: %d, got: %d", 5, got)
+	}
+	if got := StringField("name").Default("anonymous").Get(fd); got != "" {
+		t.Errorf("Get of empty string returned invalid value: expected: %q, got: %q", "", got)
+	}
+	if _, err := IntField("missing").Lookup(fd); !errors.Is(err, ErrNoValue) {
+		t.Errorf("Lookup of missing value returned invalid error: expected: %v, got: %v", ErrNoValue, err)
+	}
+	if _, err := IntField("invalid").Lookup(fd); err == nil {
+		t.Errorf("Lookup of invalid value returned no error")
+	}
+}
+
+func TestNewField(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["tags"] = []string{"a,b"}
+
+	tags := NewField("tags", func(s string) ([]string, error) {
+		return strings.Split(s, ","), nil
+	})
+	if got := tags.Get(fd); len(got) != 2 {
+		t.Errorf("Get returned invalid value: expected: %v, got: %v", []string{"a", "b"}, got)
+	}
+
+	expected := "Min is not supported for field type []string!"
+	defer func() {
+		r := recover()
+		if r != expected {
+			t.Errorf("Min paniced with invalid message: expected: %q, got: %v", expected, r)
+		}
+	}()
+	tags.Min(nil)
+}
@@ -0,0 +1,111 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"mime/multipart"
+	"os"
+)
+
+// File is an element of FormDataFile. It extends the *multipart.FileHeader of
+// a file part with the location the parser stored the file content at.
+type File struct {
+	*multipart.FileHeader
+
+	// content of files kept in memory
+	content []byte
+
+	// key of files stored in a FileStore
+	store   FileStore
+	key     string
+	removed bool
+
+	// content type and digests computed while parsing
+	sniffedType string
+	sums        map[HashAlgorithm][]byte
+}
+
+// Open opens and returns the content of the file. Files which were not stored
+// by this package are opened by multipart.FileHeader.Open.
+func (f *File) Open() (multipart.File, error) {
+	if f.content != nil {
+		return newSectionReadCloser(f.content), nil
+	}
+	if f.removed {
+		return nil, os.ErrNotExist
+	}
+	if f.store != nil {
+		return f.store.Open(f.key)
+	}
+	return f.FileHeader.Open()
+}
+
+// RawFilename returns the filename parameter of the Content-Disposition header
+// as sent by the client. FileHeader.Filename holds the decoded filename of
+// files parsed by this package.
+func (f *File) RawFilename() string {
+	_, raw := dispositionFilename(f.Header.Get("Content-Disposition"))
+	return raw
+}
+
+// ContentType returns the Content-Type of the file as declared by the client.
+func (f *File) ContentType() string {
+	return f.Header.Get("Content-Type")
+}
+
+// SniffedType returns the content type of the file detected by its magic
+// bytes, see http.DetectContentType. The type is detected while parsing,
+// files not parsed by this package are opened to detect it. If the file
+// cannot be read, an empty string is returned.
+func (f *File) SniffedType() string {
+	if f.sniffedType != "" {
+		return f.sniffedType
+	}
+	file, err := f.Open()
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	t, err := sniff(file)
+	if err != nil {
+		return ""
+	}
+	return t
+}
+
+// remove removes the file from its FileStore if there is one. Removing a file
+// a second time has no effect.
+func (f *File) remove() error {
+	if f.store == nil || f.removed {
+		return nil
+	}
+	if err := f.store.Remove(f.key); err != nil {
+		return err
+	}
+	f.removed = true
+	return nil
+}
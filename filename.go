@@ -0,0 +1,247 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var errInvalidExtValue = errors.New("invalid extended parameter value")
+
+// whatwgUnescaper reverts the escaping browsers apply to filenames of
+// multipart/form-data parts as specified by the WHATWG HTML standard.
+var whatwgUnescaper = strings.NewReplacer("%22", `"`, "%0D", "\r", "%0A", "\n")
+
+// dispositionFilename returns the decoded filename of a Content-Disposition
+// header value and the raw parameter value it was decoded from. The
+// parameters are used in the following order:
+//
+//   - filename* as specified by RFC 5987, e.g. filename*=UTF-8'en'%E2%82%AC.txt
+//   - filename*0, filename*1*, ... as specified by RFC 2231
+//   - filename with escaped quotes and newlines as sent by browsers
+//
+// Like multipart.Part.FileName, directories are removed from the decoded
+// filename.
+func dispositionFilename(v string) (filename, raw string) {
+	params := dispositionParams(v)
+
+	if ext, ok := params["filename*"]; ok {
+		if name, err := decodeExtValue(ext); err == nil {
+			return baseFilename(name), ext
+		}
+	}
+	if name, raw, ok := decodeContinuations(params, "filename"); ok {
+		return baseFilename(name), raw
+	}
+
+	raw = params["filename"]
+	return baseFilename(whatwgUnescaper.Replace(raw)), raw
+}
+
+func baseFilename(name string) string {
+	if name == "" {
+		return ""
+	}
+	return filepath.Base(name)
+}
+
+// dispositionParams parses the parameters of a Content-Disposition header
+// value without decoding them. Parameter names are lower-cased, if a
+// parameter is repeated the first value is used.
+func dispositionParams(v string) map[string]string {
+	params := make(map[string]string)
+
+	i := strings.IndexByte(v, ';')
+	if i < 0 {
+		return params
+	}
+	v = v[i+1:]
+
+	for {
+		v = strings.TrimLeft(v, " \t")
+		i := strings.IndexAny(v, "=;")
+		if i < 0 {
+			return params
+		}
+		if v[i] == ';' {
+			v = v[i+1:]
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSpace(v[:i]))
+		v = strings.TrimLeft(v[i+1:], " \t")
+
+		var value string
+		if strings.HasPrefix(v, `"`) {
+			value, v = consumeQuotedString(v)
+		} else {
+			end := strings.IndexByte(v, ';')
+			if end < 0 {
+				end = len(v)
+			}
+			value, v = strings.TrimSpace(v[:end]), v[end:]
+		}
+		if _, exists := params[name]; !exists {
+			params[name] = value
+		}
+
+		end := strings.IndexByte(v, ';')
+		if end < 0 {
+			return params
+		}
+		v = v[end+1:]
+	}
+}
+
+// consumeQuotedString consumes a quoted-string at the beginning of v and
+// returns its unquoted value and the rest of v. Like mime.ParseMediaType, a
+// backslash only escapes a following quote or backslash, as browsers do not
+// escape backslashes of Windows paths.
+func consumeQuotedString(v string) (value, rest string) {
+	var b strings.Builder
+	for i := 1; i < len(v); i++ {
+		switch c := v[i]; {
+		case c == '"':
+			return b.String(), v[i+1:]
+		case c == '\\' && i+1 < len(v) && (v[i+1] == '"' || v[i+1] == '\\'):
+			b.WriteByte(v[i+1])
+			i++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	// unterminated quoted-string
+	return b.String(), ""
+}
+
+// decodeExtValue decodes an extended parameter value of the form
+// charset'language'percent-encoded-value as specified by RFC 5987.
+func decodeExtValue(v string) (string, error) {
+	parts := strings.SplitN(v, "'", 3)
+	if len(parts) != 3 {
+		return "", errInvalidExtValue
+	}
+	b, err := percentDecode(parts[2])
+	if err != nil {
+		return "", err
+	}
+	return decodeCharset(b, parts[0], true)
+}
+
+// decodeContinuations decodes a parameter split into multiple sections as
+// specified by RFC 2231, e.g. name*0*=UTF-8'en'%E2%82%AC; name*1=.txt.
+func decodeContinuations(params map[string]string, name string) (value, raw string, ok bool) {
+	type section struct {
+		index   int
+		value   string
+		encoded bool
+	}
+
+	var sections []section
+	prefix := name + "*"
+	for key, v := range params {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		s := section{value: v}
+		index := key[len(prefix):]
+		if strings.HasSuffix(index, "*") {
+			s.encoded = true
+			index = index[:len(index)-1]
+		}
+		n, err := strconv.Atoi(index)
+		if err != nil {
+			continue
+		}
+		s.index = n
+		sections = append(sections, s)
+	}
+	if len(sections) == 0 {
+		return "", "", false
+	}
+	sort.Slice(sections, func(i, j int) bool { return sections[i].index < sections[j].index })
+
+	var charset string
+	var b []byte
+	var rawValues []string
+	for i, s := range sections {
+		if s.index != i {
+			// sections must be numbered without gaps
+			return "", "", false
+		}
+		rawValues = append(rawValues, s.value)
+		if !s.encoded {
+			b = append(b, s.value...)
+			continue
+		}
+		v := s.value
+		if i == 0 {
+			parts := strings.SplitN(v, "'", 3)
+			if len(parts) != 3 {
+				return "", "", false
+			}
+			charset, v = parts[0], parts[2]
+		}
+		decoded, err := percentDecode(v)
+		if err != nil {
+			return "", "", false
+		}
+		b = append(b, decoded...)
+	}
+
+	value, err := decodeCharset(b, charset, true)
+	if err != nil {
+		return "", "", false
+	}
+	return value, strings.Join(rawValues, ""), true
+}
+
+// percentDecode decodes %XX escapes of s, other characters are kept as they
+// are.
+func percentDecode(s string) ([]byte, error) {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b = append(b, s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return nil, errInvalidExtValue
+		}
+		n, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+		if err != nil {
+			return nil, errInvalidExtValue
+		}
+		b = append(b, byte(n))
+		i += 2
+	}
+	return b, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+)
+
+func TestDispositionFilename(t *testing.T) {
+	testcases := []struct {
+		header   string
+		filename string
+		raw      string
+	}{
+		{`form-data; name="a"`, "", ""},
+		{`form-data; name="a"; filename=""`, "", ""},
+		{`form-data; name="a"; filename="plain.txt"`, "plain.txt", "plain.txt"},
+		{`form-data; name="a"; filename=token.txt`, "token.txt", "token.txt"},
+		{`form-data; name="a"; filename="say %22hi%22.txt"`, `say "hi".txt`, "say %22hi%22.txt"},
+		{`form-data; name="a"; filename="Grüße.txt"`, "Grüße.txt", "Grüße.txt"},
+		{`form-data; name="a"; filename="dir/file.txt"`, "file.txt", "dir/file.txt"},
+		{`form-data; name="a"; filename="euro.txt"; filename*=UTF-8''%E2%82%AC%20rates.txt`, "€ rates.txt", "UTF-8''%E2%82%AC%20rates.txt"},
+		{`form-data; name="a"; filename*=iso-8859-1'de'Gr%FC%DFe.txt`, "Grüße.txt", "iso-8859-1'de'Gr%FC%DFe.txt"},
+		{`form-data; name="a"; filename="fallback.txt"; filename*=unknown''x.txt`, "fallback.txt", "fallback.txt"},
+		{`form-data; name="a"; filename*1=".txt"; filename*0*=UTF-8''%E2%82%AC`, "€.txt", "UTF-8''%E2%82%AC.txt"},
+		{`form-data; name="a"; filename*0="long"; filename*1="name.txt"`, "longname.txt", "longname.txt"},
+	}
+
+	for _, testcase := range testcases {
+		filename, raw := dispositionFilename(testcase.header)
+		if filename != testcase.filename || raw != testcase.raw {
+			t.Errorf("Invalid filename of %s: expected: %q (%q), got: %q (%q)", testcase.header, testcase.filename, testcase.raw, filename, raw)
+		}
+	}
+}
+
+func TestParseFilename(t *testing.T) {
+	body := bytes.NewBuffer([]byte{})
+	multipartWriter := multipart.NewWriter(body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="upload"; filename="file.txt"; filename*=UTF-8''%E2%82%AC.txt`)
+	w, err := multipartWriter.CreatePart(header)
+	if err != nil {
+		t.Fatalf("CreatePart: %v", err)
+	}
+	w.Write([]byte("content"))
+	multipartWriter.Close()
+
+	fd, err := ParseBytes(multipartWriter.FormDataContentType(), body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := fd.GetFile("upload").First()
+	if f == nil {
+		t.Fatal("File part not parsed")
+	}
+	if f.Filename != "€.txt" {
+		t.Errorf("Invalid filename: expected: %q, got: %q", "€.txt", f.Filename)
+	}
+	if f.RawFilename() != "UTF-8''%E2%82%AC.txt" {
+		t.Errorf("Invalid raw filename: expected: %q, got: %q", "UTF-8''%E2%82%AC.txt", f.RawFilename())
+	}
+	if fd.Parts()[0].Filename != "€.txt" {
+		t.Errorf("Invalid part filename: expected: %q, got: %q", "€.txt", fd.Parts()[0].Filename)
+	}
+}
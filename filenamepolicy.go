@@ -0,0 +1,203 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// DefaultMaxFilenameLength is the maximum length of a safe filename in
+	// bytes, which most filesystems support.
+	DefaultMaxFilenameLength = 255
+
+	// DefaultFallbackFilename is the prefix of fallback filenames.
+	DefaultFallbackFilename = "file"
+)
+
+// windowsReservedNames are device names which cannot be used as filename on
+// Windows, regardless of the extension.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// FilenamePolicy configures how an uploaded filename is turned into a filename
+// which is safe to store on disk.
+type FilenamePolicy struct {
+	// MaxLength limits the length of the filename in bytes. The extension is
+	// kept when shortening. If zero, DefaultMaxFilenameLength is used.
+	MaxLength int
+
+	// Normalize is applied to the filename before sanitizing it. If
+	// Normalize is nil, Unicode is normalized to NFC, so the NFC and NFD
+	// spellings of a name result in the same filename.
+	Normalize func(string) string
+
+	// Fallback is the prefix of the filename used if no safe filename
+	// remains. If empty, DefaultFallbackFilename is used.
+	Fallback string
+
+	// AllowHidden keeps leading dots, which hide files on Unix systems.
+	AllowHidden bool
+}
+
+// DefaultFilenamePolicy is the FilenamePolicy used by File.SafeFilename.
+var DefaultFilenamePolicy = FilenamePolicy{}
+
+// SafeFilename returns the filename of f sanitized by DefaultFilenamePolicy.
+func (f *File) SafeFilename() string {
+	return DefaultFilenamePolicy.Sanitize(f.Filename)
+}
+
+// SafeFilenameWith returns the filename of f sanitized by the given policy.
+func (f *File) SafeFilenameWith(policy FilenamePolicy) string {
+	return policy.Sanitize(f.Filename)
+}
+
+// Sanitize turns name into a filename which is safe to store on disk:
+//
+//   - the filename is converted to valid UTF-8 and normalized to NFC or
+//     with Normalize, if set
+//   - path components are removed, both / and \ are treated as separator
+//   - control and formatting characters, like bidirectional overrides, are
+//     removed and characters reserved on Windows are replaced by _
+//   - leading spaces and dots as well as trailing spaces and dots are removed
+//   - the filename is shortened to MaxLength bytes keeping the extension
+//
+// If the result is empty, "." or "..", or a reserved name on Windows like CON
+// or LPT1, a fallback name is returned. The fallback name consists of
+// Fallback, a hash of name and the extension of name, if any. It is
+// deterministic, the same name always results in the same fallback name.
+func (p FilenamePolicy) Sanitize(name string) string {
+	s := strings.ToValidUTF8(name, "")
+	if p.Normalize != nil {
+		s = p.Normalize(s)
+	} else {
+		s = norm.NFC.String(s)
+	}
+
+	if i := strings.LastIndexAny(s, `/\`); i >= 0 {
+		s = s[i+1:]
+	}
+	s = strings.Map(sanitizeFilenameRune, s)
+
+	s = strings.TrimLeft(s, " ")
+	if !p.AllowHidden {
+		s = strings.TrimLeft(s, ". ")
+	}
+	s = strings.TrimRight(s, ". ")
+	s = p.shorten(s)
+
+	if s == "" || s == "." || s == ".." || isWindowsReservedName(s) {
+		return p.fallback(name, s)
+	}
+	return s
+}
+
+func sanitizeFilenameRune(r rune) rune {
+	switch {
+	case unicode.IsControl(r), unicode.Is(unicode.Cf, r):
+		return -1
+	case strings.ContainsRune(`<>:"|?*`, r):
+		return '_'
+	}
+	return r
+}
+
+func isWindowsReservedName(s string) bool {
+	base := s
+	if i := strings.IndexByte(base, '.'); i >= 0 {
+		base = base[:i]
+	}
+	return windowsReservedNames[strings.ToUpper(strings.TrimRight(base, " "))]
+}
+
+// shorten truncates s to MaxLength bytes at a rune boundary. The extension is
+// kept unless it is longer than half of MaxLength.
+func (p FilenamePolicy) shorten(s string) string {
+	max := p.MaxLength
+	if max <= 0 {
+		max = DefaultMaxFilenameLength
+	}
+	if len(s) <= max {
+		return s
+	}
+
+	ext := filenameExt(s)
+	if len(ext) > max/2 {
+		ext = ""
+	}
+	base := s[:len(s)-len(ext)]
+	base = truncateUTF8(base, max-len(ext))
+	return strings.TrimRight(base, ". ") + ext
+}
+
+// fallback returns Fallback, a hash of name and the extension of the
+// sanitized name.
+func (p FilenamePolicy) fallback(name, sanitized string) string {
+	prefix := p.Fallback
+	if prefix == "" {
+		prefix = DefaultFallbackFilename
+	}
+	sum := sha256.Sum256([]byte(name))
+	ext := filenameExt(sanitized)
+	if ext == sanitized {
+		// a name like ".txt" has no extension, it is hidden
+		ext = ""
+	}
+	return prefix + "-" + hex.EncodeToString(sum[:8]) + ext
+}
+
+// filenameExt returns the extension of s including the dot or an empty string.
+func filenameExt(s string) string {
+	i := strings.LastIndexByte(s, '.')
+	if i < 0 {
+		return ""
+	}
+	return s[i:]
+}
+
+// truncateUTF8 truncates s to at most n bytes without splitting a rune.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
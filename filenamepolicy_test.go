@@ -0,0 +1,107 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	testcases := []struct {
+		name     string
+		expected string
+	}{
+		{"invoice.pdf", "invoice.pdf"},
+		{"Grüße.txt", "Grüße.txt"},
+		{"../../etc/passwd", "passwd"},
+		{`C:\Users\me\photo.jpg`, "photo.jpg"},
+		{"evil\u202Etxt.exe", "eviltxt.exe"},
+		{"line\nbreak\x00.txt", "linebreak.txt"},
+		{`what?<is>"this".txt`, "what__is__this_.txt"},
+		{"  trailing dots... ", "trailing dots"},
+		{".htaccess", "htaccess"},
+		{"invalid\xffutf8.txt", "invalidutf8.txt"},
+	}
+
+	for _, testcase := range testcases {
+		got := DefaultFilenamePolicy.Sanitize(testcase.name)
+		if got != testcase.expected {
+			t.Errorf("Invalid sanitized filename of %q: expected: %q, got: %q", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestSanitizeFallback(t *testing.T) {
+	for _, name := range []string{"", ".", "..", "../..", "CON", "con.txt", "LPT1.tar.gz", "\x00\x01"} {
+		got := DefaultFilenamePolicy.Sanitize(name)
+		if !strings.HasPrefix(got, DefaultFallbackFilename+"-") {
+			t.Errorf("No fallback name for %q: got: %q", name, got)
+		}
+		if again := DefaultFilenamePolicy.Sanitize(name); again != got {
+			t.Errorf("Fallback name not deterministic for %q: %q != %q", name, got, again)
+		}
+	}
+
+	if got := DefaultFilenamePolicy.Sanitize("con.txt"); !strings.HasSuffix(got, ".txt") {
+		t.Errorf("Fallback name lost extension: got: %q", got)
+	}
+	if got := (FilenamePolicy{Fallback: "upload"}).Sanitize(".."); !strings.HasPrefix(got, "upload-") {
+		t.Errorf("Fallback prefix not used: got: %q", got)
+	}
+}
+
+func TestSanitizePolicy(t *testing.T) {
+	long := strings.Repeat("ü", 200) + ".txt"
+	got := DefaultFilenamePolicy.Sanitize(long)
+	if len(got) > DefaultMaxFilenameLength || !strings.HasSuffix(got, ".txt") {
+		t.Errorf("Invalid shortened filename: length: %d, got: %q", len(got), got)
+	}
+
+	policy := FilenamePolicy{
+		MaxLength:   10,
+		Normalize:   strings.ToLower,
+		AllowHidden: true,
+	}
+	if got := policy.Sanitize(".Hidden-File.TXT"); got != ".hidde.txt" {
+		t.Errorf("Invalid sanitized filename: expected: %q, got: %q", ".hidde.txt", got)
+	}
+
+	nfc, nfd := "Caf\u00e9.txt", "Cafe\u0301.txt"
+	if got, expected := DefaultFilenamePolicy.Sanitize(nfd), DefaultFilenamePolicy.Sanitize(nfc); got != expected || got != nfc {
+		t.Errorf("NFD filename not normalized: expected: %q, got: %q", nfc, got)
+	}
+
+	f := &File{FileHeader: &multipart.FileHeader{Filename: "../a.txt"}}
+	if got := f.SafeFilename(); got != "a.txt" {
+		t.Errorf("Invalid SafeFilename: expected: %q, got: %q", "a.txt", got)
+	}
+	if got := f.SafeFilenameWith(FilenamePolicy{Normalize: strings.ToUpper}); got != "A.TXT" {
+		t.Errorf("Invalid SafeFilenameWith: expected: %q, got: %q", "A.TXT", got)
+	}
+}
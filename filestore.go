@@ -0,0 +1,252 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+// ErrInvalidKey is returned by the built-in FileStores if a key does not
+// belong to the store.
+var ErrInvalidKey = errors.New("formdata: invalid file store key")
+
+// defaultFileStore stores files in the default directory for temporary files.
+var defaultFileStore = NewDirStore("")
+
+// FileStore stores the content of file parts which do not fit into the memory
+// of the parser. Implementations must be safe for concurrent use, as a single
+// FileStore is usually shared by all requests.
+type FileStore interface {
+	// Create stores the content read from r and returns the key under which
+	// the content can be opened and removed.
+	Create(r io.Reader) (key string, err error)
+
+	// Open opens the content stored under key.
+	Open(key string) (multipart.File, error)
+
+	// Remove removes the content stored under key.
+	Remove(key string) error
+}
+
+// MemoryStore is a FileStore keeping all files in memory.
+type MemoryStore struct {
+	mu    sync.Mutex
+	files map[string][]byte
+	next  int
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{files: make(map[string][]byte)}
+}
+
+// Create implements FileStore.
+func (s *MemoryStore) Create(r io.Reader) (string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next++
+	key := strconv.Itoa(s.next)
+	s.files[key] = b
+	return key, nil
+}
+
+// Open implements FileStore.
+func (s *MemoryStore) Open(key string) (multipart.File, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.files[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return newSectionReadCloser(b), nil
+}
+
+// Remove implements FileStore.
+func (s *MemoryStore) Remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.files, key)
+	return nil
+}
+
+// Len returns the number of files in the store.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.files)
+}
+
+// DirStore is a FileStore keeping every file in a separate file of a
+// directory.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a DirStore for the directory dir. If dir is the empty
+// string, the default directory for temporary files (see os.TempDir) is used.
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{dir: dir}
+}
+
+// Create implements FileStore.
+func (s *DirStore) Create(r io.Reader) (string, error) {
+	f, err := os.CreateTemp(s.dir, "formdata-")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return filepath.Base(f.Name()), nil
+}
+
+// Open implements FileStore.
+func (s *DirStore) Open(key string) (multipart.File, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// Remove implements FileStore. Removing a file which does not exist is not an
+// error.
+func (s *DirStore) Remove(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *DirStore) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) {
+		return "", ErrInvalidKey
+	}
+	dir := s.dir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, key), nil
+}
+
+// ContentAddressedStore is a FileStore keeping files in a directory under the
+// hex encoded SHA-256 of their content. Files with equal content are stored
+// only once and removed after every key returned by Create was removed.
+type ContentAddressedStore struct {
+	dir *DirStore
+
+	mu   sync.Mutex
+	refs map[string]int
+}
+
+// NewContentAddressedStore returns a ContentAddressedStore for the directory
+// dir. If dir is the empty string, the default directory for temporary files
+// (see os.TempDir) is used.
+func NewContentAddressedStore(dir string) *ContentAddressedStore {
+	return &ContentAddressedStore{
+		dir:  NewDirStore(dir),
+		refs: make(map[string]int),
+	}
+}
+
+// Create implements FileStore.
+func (s *ContentAddressedStore) Create(r io.Reader) (string, error) {
+	h := sha256.New()
+	tmp, err := s.dir.Create(io.TeeReader(r, h))
+	if err != nil {
+		return "", err
+	}
+	key := hex.EncodeToString(h.Sum(nil))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.refs[key] > 0 {
+		s.refs[key]++
+		return key, s.dir.Remove(tmp)
+	}
+	tmpPath, _ := s.dir.path(tmp)
+	path, _ := s.dir.path(key)
+	if err := os.Rename(tmpPath, path); err != nil {
+		s.dir.Remove(tmp)
+		return "", err
+	}
+	s.refs[key] = 1
+	return key, nil
+}
+
+// Open implements FileStore.
+func (s *ContentAddressedStore) Open(key string) (multipart.File, error) {
+	return s.dir.Open(key)
+}
+
+// Remove implements FileStore.
+func (s *ContentAddressedStore) Remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.refs[key] > 1 {
+		s.refs[key]--
+		return nil
+	}
+	delete(s.refs, key)
+	return s.dir.Remove(key)
+}
+
+// sectionReadCloser implements multipart.File for content in memory.
+type sectionReadCloser struct {
+	*io.SectionReader
+}
+
+func newSectionReadCloser(b []byte) sectionReadCloser {
+	return sectionReadCloser{io.NewSectionReader(bytes.NewReader(b), 0, int64(len(b)))}
+}
+
+func (rc sectionReadCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFileStores(t *testing.T) {
+	stores := map[string]FileStore{
+		"MemoryStore":           NewMemoryStore(),
+		"DirStore":              NewDirStore(t.TempDir()),
+		"ContentAddressedStore": NewContentAddressedStore(t.TempDir()),
+	}
+
+	for name, store := range stores {
+		key, err := store.Create(strings.NewReader("file content"))
+		if err != nil {
+			t.Fatalf("%s: Create: %v", name, err)
+		}
+
+		f, err := store.Open(key)
+		if err != nil {
+			t.Fatalf("%s: Open: %v", name, err)
+		}
+		got, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: ReadAll: %v", name, err)
+		}
+		if string(got) != "file content" {
+			t.Errorf("%s: Invalid content: expected: %q, got: %q", name, "file content", got)
+		}
+
+		if err := store.Remove(key); err != nil {
+			t.Errorf("%s: Remove: %v", name, err)
+		}
+		if _, err := store.Open(key); !os.IsNotExist(err) {
+			t.Errorf("%s: File not removed: got: %v", name, err)
+		}
+	}
+}
+
+func TestDirStoreInvalidKey(t *testing.T) {
+	store := NewDirStore(t.TempDir())
+
+	for _, key := range []string{"", "../passwd", "a/b"} {
+		if _, err := store.Open(key); err != ErrInvalidKey {
+			t.Errorf("Invalid error for key %q: expected: %v, got: %v", key, ErrInvalidKey, err)
+		}
+	}
+}
+
+func TestContentAddressedStore(t *testing.T) {
+	store := NewContentAddressedStore(t.TempDir())
+
+	key1, err := store.Create(strings.NewReader("same content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key2, err := store.Create(strings.NewReader("same content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key1 != key2 {
+		t.Errorf("Equal content stored under different keys: %s, %s", key1, key2)
+	}
+	if err := store.Remove(key1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Open(key2); err != nil {
+		t.Errorf("File removed while still referenced: %v", err)
+	}
+	if err := store.Remove(key2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Open(key2); !os.IsNotExist(err) {
+		t.Errorf("File not removed: got: %v", err)
+	}
+}
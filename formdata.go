@@ -26,12 +26,50 @@
 
 package formdata
 
-import "mime/multipart"
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"strings"
+	"sync"
+)
 
 // FormData extends multipart.Form with additional validation capabilities.
 type FormData struct {
 	*multipart.Form
 	errors []*ValidationError
+
+	// files holds the files stored by this package
+	files map[*multipart.FileHeader]*File
+
+	// parts holds all parts in the order of the request body
+	parts []*Part
+
+	// discarded holds the files of mime/multipart dropped from File
+	discarded []*multipart.FileHeader
+
+	// keyStyle is the notation of nested keys
+	keyStyle KeyStyle
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func newFormData() *FormData {
+	return &FormData{
+		Form: &multipart.Form{
+			Value: make(map[string][]string),
+			File:  make(map[string][]*multipart.FileHeader),
+		},
+		errors: make([]*ValidationError, 0),
+		files:  make(map[*multipart.FileHeader]*File),
+	}
+}
+
+// addFile adds a file stored by this package to FormData.File.
+func (fd *FormData) addFile(key string, f *File) {
+	fd.File[key] = append(fd.File[key], f.FileHeader)
+	fd.files[f.FileHeader] = f
 }
 
 func (fd *FormData) validate(key string, isFile bool) *Validation {
@@ -70,6 +108,13 @@ func (fd *FormData) Errors() []string {
 	return errors
 }
 
+// ValidationErrors returns all validation errors, e.g. for checking their
+// keys and codes. If there are no validation errors an empty slice is
+// returned.
+func (fd *FormData) ValidationErrors() []*ValidationError {
+	return append([]*ValidationError{}, fd.errors...)
+}
+
 // Exists checks if FormData.Value has given key.
 func (fd *FormData) Exists(key string) bool {
 	_, exists := fd.Value[key]
@@ -89,22 +134,130 @@ func (fd *FormData) Get(key string) FormDataValue {
 	return v
 }
 
+// Parts returns all parts in the order of the request body. Parts is empty
+// for FormData parsed by Parse or ParseMax, as mime/multipart does not
+// preserve the order of parts.
+func (fd *FormData) Parts() []*Part {
+	return fd.parts
+}
+
 // FileExists checks if FormData.File has given key.
 func (fd *FormData) FileExists(key string) bool {
 	_, exists := fd.File[key]
 	return exists
 }
 
-// GetFile returns the *multipart.FileHeader array associated with the given key.
-// If there are no value associated with the key, GetFile returns an empty
-// []*multipart.FileHeader.
+// GetFile returns the *File array associated with the given key. If there are
+// no value associated with the key, GetFile returns an empty []*File.
 func (fd *FormData) GetFile(key string) FormDataFile {
 	if fd.File == nil {
-		return []*multipart.FileHeader{}
+		return []*File{}
 	}
-	f := fd.File[key]
-	if len(f) == 0 {
-		return []*multipart.FileHeader{}
+	headers := fd.File[key]
+	if len(headers) == 0 {
+		return []*File{}
+	}
+	f := make(FormDataFile, len(headers))
+	for i, fh := range headers {
+		f[i] = fd.file(fh)
 	}
 	return f
 }
+
+// file returns the *File of fh. Files not stored by this package are wrapped
+// as they are.
+func (fd *FormData) file(fh *multipart.FileHeader) *File {
+	if f, ok := fd.files[fh]; ok {
+		return f
+	}
+	return &File{FileHeader: fh}
+}
+
+// RemoveAll removes any temporary files associated with FormData. If removing
+// fails for some files, the returned error contains the errors of all of them.
+func (fd *FormData) RemoveAll() error {
+	errs := &errorList{}
+	for _, f := range fd.files {
+		if err := f.remove(); err != nil {
+			errs.errs = append(errs.errs, err)
+		}
+	}
+	if fd.Form != nil {
+		if err := fd.Form.RemoveAll(); err != nil {
+			errs.errs = append(errs.errs, err)
+		}
+	}
+	if len(fd.discarded) > 0 {
+		discarded := &multipart.Form{File: map[string][]*multipart.FileHeader{"": fd.discarded}}
+		if err := discarded.RemoveAll(); err != nil {
+			errs.errs = append(errs.errs, err)
+		}
+	}
+	if len(errs.errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Close releases all resources of FormData by envoking RemoveAll. Close is
+// safe to be called multiple times and concurrently, subsequent calls return
+// the result of the first call.
+func (fd *FormData) Close() error {
+	fd.closeOnce.Do(func() {
+		fd.closeErr = fd.RemoveAll()
+	})
+	return fd.closeErr
+}
+
+// CloseOnDone closes FormData as soon as ctx is done. Passing the context of
+// an http.Request removes all temporary files when the handler returns or the
+// client disconnects.
+func (fd *FormData) CloseOnDone(ctx context.Context) {
+	if ctx.Done() == nil {
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		fd.Close()
+	}()
+}
+
+// errorList is an error aggregating multiple errors.
+type errorList struct {
+	errs []error
+}
+
+func (l *errorList) Error() string {
+	msgs := make([]string, len(l.errs))
+	for i, err := range l.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the aggregated errors.
+func (l *errorList) Unwrap() []error {
+	return l.errs
+}
+
+// Is reports whether any of the aggregated errors matches target. errors.Is
+// only follows Unwrap() []error since Go 1.20.
+func (l *errorList) Is(target error) bool {
+	for _, err := range l.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the aggregated errors matching target. errors.As
+// only follows Unwrap() []error since Go 1.20.
+func (l *errorList) As(target interface{}) bool {
+	for _, err := range l.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
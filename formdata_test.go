@@ -27,17 +27,20 @@
 package formdata
 
 import (
+	"errors"
 	"mime/multipart"
+	"strings"
 	"testing"
 )
 
 func emptyFormData() *FormData {
 	return &FormData{
-		&multipart.Form{
+		Form: &multipart.Form{
 			Value: make(map[string][]string),
 			File:  make(map[string][]*multipart.FileHeader),
 		},
-		make([]*ValidationError, 0),
+		errors: make([]*ValidationError, 0),
+		files:  make(map[*multipart.FileHeader]*File),
 	}
 }
 
@@ -101,11 +104,11 @@ func TestErrors(t *testing.T) {
 
 func TestGet(t *testing.T) {
 	fdWithNilValue := &FormData{
-		&multipart.Form{
+		Form: &multipart.Form{
 			Value: nil,
 			File:  make(map[string][]*multipart.FileHeader),
 		},
-		make([]*ValidationError, 0),
+		errors: make([]*ValidationError, 0),
 	}
 
 	// if Value is nil any check should return an empty string array
@@ -166,11 +169,11 @@ func TestFileExists(t *testing.T) {
 
 func TestGetFile(t *testing.T) {
 	fdWithNilFile := &FormData{
-		&multipart.Form{
+		Form: &multipart.Form{
 			Value: make(map[string][]string),
 			File:  nil,
 		},
-		make([]*ValidationError, 0),
+		errors: make([]*ValidationError, 0),
 	}
 
 	// if Value is nil any check should return an empty string array
@@ -191,3 +194,106 @@ func TestGetFile(t *testing.T) {
 		t.Errorf("Array length mismatch: expected: 1, got: %d", len(doesExist))
 	}
 }
+
+type failingStore struct {
+	*MemoryStore
+}
+
+var errRemove = errors.New("remove failed")
+
+func (s failingStore) Remove(key string) error {
+	return errRemove
+}
+
+func TestClose(t *testing.T) {
+	store := NewMemoryStore()
+	fd := emptyFormData()
+	for _, name := range []string{"a.txt", "b.txt"} {
+		key, err := store.Create(strings.NewReader(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.addFile("attachment", &File{FileHeader: &multipart.FileHeader{Filename: name}, store: store, key: key})
+	}
+
+	if err := fd.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Files not removed: got: %d", store.Len())
+	}
+	if err := fd.Close(); err != nil {
+		t.Errorf("Second Close failed: %v", err)
+	}
+
+	fd = emptyFormData()
+	failing := failingStore{NewMemoryStore()}
+	for _, name := range []string{"a.txt", "b.txt"} {
+		key, err := failing.Create(strings.NewReader(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.addFile("attachment", &File{FileHeader: &multipart.FileHeader{Filename: name}, store: failing, key: key})
+	}
+
+	err := fd.Close()
+	expected := "remove failed; remove failed"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Invalid error: expected: %s, got: %v", expected, err)
+	}
+	if errs, ok := err.(interface{ Unwrap() []error }); !ok || len(errs.Unwrap()) != 2 {
+		t.Errorf("Errors not aggregated: got: %#v", err)
+	}
+	if err2 := fd.Close(); err2 != err {
+		t.Errorf("Second Close returned different error: expected: %v, got: %v", err, err2)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["email"] = []string{"example.com"}
+	fd.Validate("email").Required().HasN(2).MatchEmail()
+	fd.Validate("name").Required()
+
+	expected := []struct {
+		key  string
+		code ValidationCode
+	}{
+		{"email", CodeCount},
+		{"email", CodeMatch},
+		{"name", CodeRequired},
+	}
+	errs := fd.ValidationErrors()
+	if len(errs) != len(expected) {
+		t.Fatalf("Error count mismatch: expected: %d, got: %d", len(expected), len(errs))
+	}
+	for i, err := range errs {
+		if err.Key() != expected[i].key || err.Code() != expected[i].code {
+			t.Errorf("Invalid error %d: expected: %s %s, got: %s %s", i, expected[i].key, expected[i].code, err.Key(), err.Code())
+		}
+	}
+	if errs[2].Message() != "is required" {
+		t.Errorf("Invalid message: expected: is required, got: %s", errs[2].Message())
+	}
+}
+
+func TestErrorList(t *testing.T) {
+	ve := &ValidationError{key: "age", code: CodeConversion, message: "Element 0 is not a valid int"}
+	l := &errorList{errs: []error{errors.New("first"), ErrNoValue, ve}}
+
+	// call Is and As directly, errors.Is and errors.As only use them before
+	// Go 1.20
+	if !l.Is(ErrNoValue) {
+		t.Errorf("Is did not find %v", ErrNoValue)
+	}
+	if l.Is(ErrBodyTooLarge) {
+		t.Errorf("Is found %v", ErrBodyTooLarge)
+	}
+	var target *ValidationError
+	if !l.As(&target) || target != ve {
+		t.Errorf("As did not find the validation error: expected: %v, got: %v", ve, target)
+	}
+	if !errors.As(l, &target) {
+		t.Errorf("errors.As did not find the validation error")
+	}
+}
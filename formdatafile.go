@@ -26,14 +26,12 @@
 
 package formdata
 
-import "mime/multipart"
-
 // FormDataFile is the value of an element in FormData.File.
-type FormDataFile []*multipart.FileHeader
+type FormDataFile []*File
 
-// At returns a single *multipart.FileHeader of FormDataFile at the given
-// index. If index is out of bound nil is returned.
-func (f FormDataFile) At(index int) *multipart.FileHeader {
+// At returns a single *File of FormDataFile at the given index. If index is
+// out of bound nil is returned.
+func (f FormDataFile) At(index int) *File {
 	if len(f) < index+1 || index < 0 {
 		return nil
 	}
@@ -41,6 +39,6 @@ func (f FormDataFile) At(index int) *multipart.FileHeader {
 }
 
 // First envokes FormDataFile.
-func (f FormDataFile) First() *multipart.FileHeader {
+func (f FormDataFile) First() *File {
 	return f.At(0)
 }
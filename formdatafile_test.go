@@ -39,7 +39,7 @@ var filemap = map[string][]string{
 func sampleDocuments() FormDataFile {
 	formDataFile := FormDataFile{}
 	for _, f := range filemap["documents"] {
-		formDataFile = append(formDataFile, &multipart.FileHeader{Filename: f})
+		formDataFile = append(formDataFile, &File{FileHeader: &multipart.FileHeader{Filename: f}})
 	}
 
 	return formDataFile
@@ -47,7 +47,7 @@ func sampleDocuments() FormDataFile {
 func samplePhotos() FormDataFile {
 	formDataFile := FormDataFile{}
 	for _, f := range filemap["photos"] {
-		formDataFile = append(formDataFile, &multipart.FileHeader{Filename: f})
+		formDataFile = append(formDataFile, &File{FileHeader: &multipart.FileHeader{Filename: f}})
 	}
 
 	return formDataFile
@@ -0,0 +1,72 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdatatest
+
+import (
+	"testing"
+
+	"github.com/neox5/go-formdata"
+)
+
+// HasValidationError reports whether fd has a validation error for key with
+// the given code.
+func HasValidationError(fd *formdata.FormData, key string, code formdata.ValidationCode) bool {
+	for _, err := range fd.ValidationErrors() {
+		if err.Key() == key && err.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// AssertValidationError fails the test if fd has no validation error for key
+// with the given code.
+func AssertValidationError(t testing.TB, fd *formdata.FormData, key string, code formdata.ValidationCode) {
+	t.Helper()
+	if !HasValidationError(fd, key, code) {
+		t.Errorf("Missing validation error: expected: '%s' %s, got: %v", key, code, fd.Errors())
+	}
+}
+
+// AssertNoValidationError fails the test if fd has a validation error for
+// key.
+func AssertNoValidationError(t testing.TB, fd *formdata.FormData, key string) {
+	t.Helper()
+	for _, err := range fd.ValidationErrors() {
+		if err.Key() == key {
+			t.Errorf("Unexpected validation error: %s", err)
+		}
+	}
+}
+
+// AssertValid fails the test if fd has any validation error.
+func AssertValid(t testing.TB, fd *formdata.FormData) {
+	t.Helper()
+	if fd.HasErrors() {
+		t.Errorf("Unexpected validation errors: %v", fd.Errors())
+	}
+}
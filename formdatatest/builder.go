@@ -0,0 +1,220 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package formdatatest provides utilities for testing handlers which parse
+// multipart/form-data with formdata.
+package formdatatest
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"path"
+	"strings"
+	"testing"
+)
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+type part struct {
+	header  textproto.MIMEHeader
+	content []byte
+}
+
+// Builder builds multipart/form-data request bodies. Errors fail the test.
+//
+//	r := formdatatest.NewBuilder(t).
+//		AddValue("from", "noreply@example.com").
+//		AddFile("attachment", "report.pdf", pdf).
+//		Header("Content-Type", "application/pdf").
+//		Request()
+type Builder struct {
+	t        testing.TB
+	parts    []*part
+	boundary string
+
+	contentType       string
+	omitBoundary      bool
+	omitCloseBoundary bool
+	truncate          int
+}
+
+// NewBuilder returns a Builder with the boundary of multipart.NewWriter.
+func NewBuilder(t testing.TB) *Builder {
+	return &Builder{t: t}
+}
+
+// AddValue adds a value part.
+func (b *Builder) AddValue(key, value string) *Builder {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, quoteEscaper.Replace(key)))
+	return b.AddPart(header, []byte(value))
+}
+
+// AddFile adds a file part with the Content-Type of the filename extension,
+// or application/octet-stream if it is unknown.
+func (b *Builder) AddFile(key, filename string, content []byte) *Builder {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+		quoteEscaper.Replace(key), quoteEscaper.Replace(filename)))
+	contentType := mime.TypeByExtension(path.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	return b.AddPart(header, content)
+}
+
+// AddFileFS adds the file name of fsys as file part, e.g. from an embed.FS
+// or os.DirFS("testdata").
+func (b *Builder) AddFileFS(key string, fsys fs.FS, name string) *Builder {
+	b.t.Helper()
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		b.t.Fatalf("formdatatest: AddFileFS: %v", err)
+	}
+	return b.AddFile(key, path.Base(name), content)
+}
+
+// AddPart adds a part with a custom header.
+func (b *Builder) AddPart(header textproto.MIMEHeader, content []byte) *Builder {
+	b.parts = append(b.parts, &part{header: header, content: content})
+	return b
+}
+
+// Header sets a header of the last added part, e.g. to override its
+// Content-Type or to add a Content-Transfer-Encoding. An empty value removes
+// the header.
+func (b *Builder) Header(key, value string) *Builder {
+	b.t.Helper()
+	if len(b.parts) == 0 {
+		b.t.Fatalf("formdatatest: Header %s without part", key)
+	}
+	header := b.parts[len(b.parts)-1].header
+	if value == "" {
+		header.Del(key)
+		return b
+	}
+	header.Set(key, value)
+	return b
+}
+
+// Boundary sets the boundary of the body.
+func (b *Builder) Boundary(boundary string) *Builder {
+	b.boundary = boundary
+	return b
+}
+
+// ContentType overrides the Content-Type of the request, e.g. to send a body
+// with a wrong media type.
+func (b *Builder) ContentType(contentType string) *Builder {
+	b.contentType = contentType
+	return b
+}
+
+// OmitBoundary removes the boundary parameter from the Content-Type of the
+// request.
+func (b *Builder) OmitBoundary() *Builder {
+	b.omitBoundary = true
+	return b
+}
+
+// OmitCloseBoundary ends the body without its closing boundary.
+func (b *Builder) OmitCloseBoundary() *Builder {
+	b.omitCloseBoundary = true
+	return b
+}
+
+// Truncate removes the last n bytes of the body.
+func (b *Builder) Truncate(n int) *Builder {
+	b.truncate = n
+	return b
+}
+
+// Body returns the body and the Content-Type of the request.
+func (b *Builder) Body() ([]byte, string) {
+	b.t.Helper()
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	if b.boundary != "" {
+		if err := w.SetBoundary(b.boundary); err != nil {
+			b.t.Fatalf("formdatatest: Boundary: %v", err)
+		}
+	}
+	for _, p := range b.parts {
+		pw, err := w.CreatePart(p.header)
+		if err != nil {
+			b.t.Fatalf("formdatatest: CreatePart: %v", err)
+		}
+		if _, err := pw.Write(p.content); err != nil {
+			b.t.Fatalf("formdatatest: Write: %v", err)
+		}
+	}
+	// the closing boundary is written by Close only
+	if !b.omitCloseBoundary {
+		if err := w.Close(); err != nil {
+			b.t.Fatalf("formdatatest: Close: %v", err)
+		}
+	}
+
+	data := body.Bytes()
+	if b.truncate > 0 {
+		if b.truncate > len(data) {
+			b.truncate = len(data)
+		}
+		data = data[:len(data)-b.truncate]
+	}
+
+	contentType := w.FormDataContentType()
+	switch {
+	case b.contentType != "":
+		contentType = b.contentType
+	case b.omitBoundary:
+		contentType = "multipart/form-data"
+	}
+	return data, contentType
+}
+
+// Request returns a POST request to "/" with the body, see NewRequest.
+func (b *Builder) Request() *http.Request {
+	b.t.Helper()
+	return b.NewRequest(http.MethodPost, "/")
+}
+
+// NewRequest returns a request for handler tests, see httptest.NewRequest.
+func (b *Builder) NewRequest(method, target string) *http.Request {
+	b.t.Helper()
+	body, contentType := b.Body()
+	r := httptest.NewRequest(method, target, bytes.NewReader(body))
+	r.Header.Set("Content-Type", contentType)
+	return r
+}
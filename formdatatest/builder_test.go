@@ -0,0 +1,116 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdatatest
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"testing/fstest"
+
+	"github.com/neox5/go-formdata"
+)
+
+func TestBuilder(t *testing.T) {
+	fsys := fstest.MapFS{"testdata/logo.svg": {Data: []byte("<svg></svg>")}}
+	r := NewBuilder(t).
+		AddValue("from", "noreply@example.com").
+		AddValue("to", "a@example.com").
+		AddValue("to", "b@example.com").
+		AddFile("attachment", "notes.txt", []byte("my notes")).
+		AddFileFS("logo", fsys, "testdata/logo.svg").
+		AddValue("note", "Gr\xfc\xdfe").
+		Header("Content-Type", "text/plain; charset=iso-8859-1").
+		Boundary("test-boundary").
+		Request()
+
+	fd, err := formdata.ParseWithOptions(r, formdata.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fd.Get("to")); got != 2 {
+		t.Errorf("Invalid number of values: expected: 2, got: %d", got)
+	}
+	if got := fd.Get("note").First(); got != "Grüße" {
+		t.Errorf("Invalid value: expected: Grüße, got: %s", got)
+	}
+
+	f := fd.GetFile("attachment").First()
+	if f == nil || f.ContentType() != "text/plain; charset=utf-8" {
+		t.Fatalf("Invalid file: got: %+v", f)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := io.ReadAll(rc); string(content) != "my notes" {
+		t.Errorf("Invalid content: expected: my notes, got: %s", content)
+	}
+	if logo := fd.GetFile("logo").First(); logo == nil || logo.Filename != "logo.svg" {
+		t.Errorf("Invalid file: got: %+v", logo)
+	}
+	if parts := fd.Parts(); len(parts) != 6 || parts[0].Name != "from" {
+		t.Errorf("Invalid parts: got: %d", len(parts))
+	}
+}
+
+func TestBuilderMalformed(t *testing.T) {
+	testcases := []struct {
+		builder  *Builder
+		expected error
+	}{
+		{NewBuilder(t).AddValue("a", "b").OmitBoundary(), formdata.ErrMissingBoundary},
+		{NewBuilder(t).AddValue("a", "b").OmitCloseBoundary(), formdata.ErrTruncatedBody},
+		{NewBuilder(t).AddValue("a", "b").Truncate(10), formdata.ErrTruncatedBody},
+		{NewBuilder(t).AddValue("a", "b").ContentType("application/json"), formdata.ErrNotMultipartFormData},
+		{NewBuilder(t).AddValue("a", "b").Header("Content-Transfer-Encoding", "x-unknown"), formdata.ErrUnsupportedEncoding},
+	}
+
+	for i, testcase := range testcases {
+		_, err := formdata.ParseWithOptions(testcase.builder.Request(), formdata.ParseOptions{})
+		if !errors.Is(err, testcase.expected) {
+			t.Errorf("Invalid error %d: expected: %v, got: %v", i, testcase.expected, err)
+		}
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	fd, err := formdata.ParseWithOptions(NewBuilder(t).AddValue("email", "example.com").Request(), formdata.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertValid(t, fd)
+
+	fd.Validate("email").MatchEmail()
+	fd.Validate("name").Required()
+	AssertValidationError(t, fd, "email", formdata.CodeMatch)
+	AssertValidationError(t, fd, "name", formdata.CodeRequired)
+	AssertNoValidationError(t, fd, "subject")
+	if HasValidationError(fd, "email", formdata.CodeRequired) {
+		t.Errorf("Unexpected validation error: 'email' %s", formdata.CodeRequired)
+	}
+}
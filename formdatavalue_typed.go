@@ -0,0 +1,274 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrNoValue is returned by the typed accessors of FormDataValue if it has no
+// element.
+var ErrNoValue = &FormDataError{"no value"}
+
+func (v FormDataValue) first() (string, error) {
+	if len(v) == 0 {
+		return "", ErrNoValue
+	}
+	return v[0], nil
+}
+
+// Int converts the first element to int.
+func (v FormDataValue) Int() (int, error) {
+	s, err := v.first()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(s)
+}
+
+// Int64 converts the first element to int64.
+func (v FormDataValue) Int64() (int64, error) {
+	s, err := v.first()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// Uint converts the first element to uint.
+func (v FormDataValue) Uint() (uint, error) {
+	s, err := v.first()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(s, 10, 0)
+	return uint(n), err
+}
+
+// Float64 converts the first element to float64.
+func (v FormDataValue) Float64() (float64, error) {
+	s, err := v.first()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// Bool converts the first element to bool like strconv.ParseBool. "on", the
+// value of checked HTML checkboxes without value attribute, is true.
+func (v FormDataValue) Bool() (bool, error) {
+	s, err := v.first()
+	if err != nil {
+		return false, err
+	}
+	return parseBool(s)
+}
+
+// Time parses the first element with the given layout, see time.Parse.
+func (v FormDataValue) Time(layout string) (time.Time, error) {
+	s, err := v.first()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(layout, s)
+}
+
+// Duration parses the first element as time.Duration, e.g. "1m30s".
+func (v FormDataValue) Duration() (time.Duration, error) {
+	s, err := v.first()
+	if err != nil {
+		return 0, err
+	}
+	return time.ParseDuration(s)
+}
+
+// IntOr returns the first element as int or def if it is missing or invalid.
+func (v FormDataValue) IntOr(def int) int {
+	if n, err := v.Int(); err == nil {
+		return n
+	}
+	return def
+}
+
+// Int64Or returns the first element as int64 or def if it is missing or
+// invalid.
+func (v FormDataValue) Int64Or(def int64) int64 {
+	if n, err := v.Int64(); err == nil {
+		return n
+	}
+	return def
+}
+
+// UintOr returns the first element as uint or def if it is missing or
+// invalid.
+func (v FormDataValue) UintOr(def uint) uint {
+	if n, err := v.Uint(); err == nil {
+		return n
+	}
+	return def
+}
+
+// Float64Or returns the first element as float64 or def if it is missing or
+// invalid.
+func (v FormDataValue) Float64Or(def float64) float64 {
+	if f, err := v.Float64(); err == nil {
+		return f
+	}
+	return def
+}
+
+// BoolOr returns the first element as bool or def if it is missing or
+// invalid.
+func (v FormDataValue) BoolOr(def bool) bool {
+	if b, err := v.Bool(); err == nil {
+		return b
+	}
+	return def
+}
+
+// TimeOr returns the first element parsed with layout or def if it is missing
+// or invalid.
+func (v FormDataValue) TimeOr(layout string, def time.Time) time.Time {
+	if t, err := v.Time(layout); err == nil {
+		return t
+	}
+	return def
+}
+
+// DurationOr returns the first element as time.Duration or def if it is
+// missing or invalid.
+func (v FormDataValue) DurationOr(def time.Duration) time.Duration {
+	if d, err := v.Duration(); err == nil {
+		return d
+	}
+	return def
+}
+
+// all calls convert for every element and stops at the first error, which
+// is returned with the index of the element.
+func (v FormDataValue) all(convert func(i int, s string) error) error {
+	for i, s := range v {
+		if err := convert(i, s); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// AllInt converts all elements to int.
+func (v FormDataValue) AllInt() ([]int, error) {
+	values := make([]int, len(v))
+	err := v.all(func(i int, s string) (err error) {
+		values[i], err = strconv.Atoi(s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// AllInt64 converts all elements to int64.
+func (v FormDataValue) AllInt64() ([]int64, error) {
+	values := make([]int64, len(v))
+	err := v.all(func(i int, s string) (err error) {
+		values[i], err = strconv.ParseInt(s, 10, 64)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// AllUint converts all elements to uint.
+func (v FormDataValue) AllUint() ([]uint, error) {
+	values := make([]uint, len(v))
+	err := v.all(func(i int, s string) error {
+		n, err := strconv.ParseUint(s, 10, 0)
+		values[i] = uint(n)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// AllFloat64 converts all elements to float64.
+func (v FormDataValue) AllFloat64() ([]float64, error) {
+	values := make([]float64, len(v))
+	err := v.all(func(i int, s string) (err error) {
+		values[i], err = strconv.ParseFloat(s, 64)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// AllBool converts all elements to bool like Bool.
+func (v FormDataValue) AllBool() ([]bool, error) {
+	values := make([]bool, len(v))
+	err := v.all(func(i int, s string) (err error) {
+		values[i], err = parseBool(s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// AllTime parses all elements with the given layout.
+func (v FormDataValue) AllTime(layout string) ([]time.Time, error) {
+	values := make([]time.Time, len(v))
+	err := v.all(func(i int, s string) (err error) {
+		values[i], err = time.Parse(layout, s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// AllDuration parses all elements as time.Duration.
+func (v FormDataValue) AllDuration() ([]time.Duration, error) {
+	values := make([]time.Duration, len(v))
+	err := v.all(func(i int, s string) (err error) {
+		values[i], err = time.ParseDuration(s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTypedAccessors(t *testing.T) {
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		name     string
+		value    FormDataValue
+		get      func(v FormDataValue) (interface{}, error)
+		expected interface{}
+		hasError bool
+	}{
+		{"Int", FormDataValue{"42", "x"}, func(v FormDataValue) (interface{}, error) { return v.Int() }, 42, false},
+		{"Int invalid", FormDataValue{"x"}, func(v FormDataValue) (interface{}, error) { return v.Int() }, 0, true},
+		{"Int64", FormDataValue{"-9000000000"}, func(v FormDataValue) (interface{}, error) { return v.Int64() }, int64(-9000000000), false},
+		{"Uint", FormDataValue{"7"}, func(v FormDataValue) (interface{}, error) { return v.Uint() }, uint(7), false},
+		{"Uint negative", FormDataValue{"-7"}, func(v FormDataValue) (interface{}, error) { return v.Uint() }, uint(0), true},
+		{"Float64", FormDataValue{"1.5"}, func(v FormDataValue) (interface{}, error) { return v.Float64() }, 1.5, false},
+		{"Bool", FormDataValue{"true"}, func(v FormDataValue) (interface{}, error) { return v.Bool() }, true, false},
+		{"Bool checkbox", FormDataValue{"on"}, func(v FormDataValue) (interface{}, error) { return v.Bool() }, true, false},
+		{"Bool invalid", FormDataValue{"yes"}, func(v FormDataValue) (interface{}, error) { return v.Bool() }, false, true},
+		{"Time", FormDataValue{"2026-10-17"}, func(v FormDataValue) (interface{}, error) { return v.Time("2006-01-02") }, day, false},
+		{"Duration", FormDataValue{"1m30s"}, func(v FormDataValue) (interface{}, error) { return v.Duration() }, 90 * time.Second, false},
+		{"AllInt", FormDataValue{"1", "2"}, func(v FormDataValue) (interface{}, error) { return v.AllInt() }, []int{1, 2}, false},
+		{"AllInt invalid", FormDataValue{"1", "x"}, func(v FormDataValue) (interface{}, error) { return v.AllInt() }, []int(nil), true},
+		{"AllInt64", FormDataValue{"3"}, func(v FormDataValue) (interface{}, error) { return v.AllInt64() }, []int64{3}, false},
+		{"AllUint", FormDataValue{"4", "5"}, func(v FormDataValue) (interface{}, error) { return v.AllUint() }, []uint{4, 5}, false},
+		{"AllFloat64", FormDataValue{"0.5", "2"}, func(v FormDataValue) (interface{}, error) { return v.AllFloat64() }, []float64{0.5, 2}, false},
+		{"AllBool", FormDataValue{"on", "false"}, func(v FormDataValue) (interface{}, error) { return v.AllBool() }, []bool{true, false}, false},
+		{"AllTime", FormDataValue{"2026-10-17"}, func(v FormDataValue) (interface{}, error) { return v.AllTime("2006-01-02") }, []time.Time{day}, false},
+		{"AllDuration", FormDataValue{"1s", "2h"}, func(v FormDataValue) (interface{}, error) { return v.AllDuration() }, []time.Duration{time.Second, 2 * time.Hour}, false},
+		{"AllInt empty", FormDataValue{}, func(v FormDataValue) (interface{}, error) { return v.AllInt() }, []int{}, false},
+	}
+
+	for _, testcase := range testcases {
+		got, err := testcase.get(testcase.value)
+		if (err != nil) != testcase.hasError {
+			t.Errorf("%s returned invalid error: expected: %v, got: %v", testcase.name, testcase.hasError, err)
+		}
+		if !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("%s returned invalid value: expected: %v, got: %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestTypedAccessorsNoValue(t *testing.T) {
+	empty := FormDataValue{}
+	if _, err := empty.Int(); !errors.Is(err, ErrNoValue) {
+		t.Errorf("Int on empty value returned invalid error: expected: %v, got: %v", ErrNoValue, err)
+	}
+	if _, err := empty.Time(time.RFC3339); !errors.Is(err, ErrNoValue) {
+		t.Errorf("Time on empty value returned invalid error: expected: %v, got: %v", ErrNoValue, err)
+	}
+}
+
+func TestTypedAccessorsOr(t *testing.T) {
+	invalid := FormDataValue{"x"}
+	def := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name     string
+		got      interface{}
+		expected interface{}
+	}{
+		{"IntOr", FormDataValue{"3"}.IntOr(1), 3},
+		{"IntOr invalid", invalid.IntOr(1), 1},
+		{"IntOr empty", FormDataValue{}.IntOr(1), 1},
+		{"Int64Or", invalid.Int64Or(2), int64(2)},
+		{"UintOr", invalid.UintOr(3), uint(3)},
+		{"Float64Or", invalid.Float64Or(0.5), 0.5},
+		{"BoolOr", FormDataValue{"on"}.BoolOr(false), true},
+		{"BoolOr invalid", invalid.BoolOr(true), true},
+		{"TimeOr", invalid.TimeOr("2006-01-02", def), def},
+		{"DurationOr", invalid.DurationOr(time.Minute), time.Minute},
+	}
+
+	for _, testcase := range testcases {
+		if testcase.got != testcase.expected {
+			t.Errorf("%s returned invalid value: expected: %v, got: %v", testcase.name, testcase.expected, testcase.got)
+		}
+	}
+}
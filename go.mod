@@ -1,3 +1,5 @@
 module github.com/neox5/go-formdata
 
-go 1.16
+go 1.18
+
+require golang.org/x/text v0.22.0
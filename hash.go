@@ -0,0 +1,135 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+)
+
+// HashAlgorithm is a digest the parser computes for file parts, see
+// ParseOptions.Hashes.
+type HashAlgorithm string
+
+// Supported hash algorithms.
+const (
+	SHA256 HashAlgorithm = "sha256"
+	SHA1   HashAlgorithm = "sha1"
+	MD5    HashAlgorithm = "md5"
+	CRC32  HashAlgorithm = "crc32"
+)
+
+func (a HashAlgorithm) new() (hash.Hash, error) {
+	switch a {
+	case SHA256:
+		return sha256.New(), nil
+	case SHA1:
+		return sha1.New(), nil
+	case MD5:
+		return md5.New(), nil
+	case CRC32:
+		return crc32.NewIEEE(), nil
+	}
+	return nil, ErrUnsupportedHash
+}
+
+// hashWriter computes multiple digests of the content written to it.
+type hashWriter struct {
+	hashes map[HashAlgorithm]hash.Hash
+	w      io.Writer
+}
+
+func newHashWriter(algorithms []HashAlgorithm) (*hashWriter, error) {
+	hw := &hashWriter{hashes: make(map[HashAlgorithm]hash.Hash, len(algorithms))}
+	writers := make([]io.Writer, 0, len(algorithms))
+	for _, a := range algorithms {
+		if _, exists := hw.hashes[a]; exists {
+			continue
+		}
+		h, err := a.new()
+		if err != nil {
+			return nil, err
+		}
+		hw.hashes[a] = h
+		writers = append(writers, h)
+	}
+	hw.w = io.MultiWriter(writers...)
+	return hw, nil
+}
+
+func (hw *hashWriter) Write(p []byte) (int, error) {
+	return hw.w.Write(p)
+}
+
+func (hw *hashWriter) sums() map[HashAlgorithm][]byte {
+	sums := make(map[HashAlgorithm][]byte, len(hw.hashes))
+	for a, h := range hw.hashes {
+		sums[a] = h.Sum(nil)
+	}
+	return sums
+}
+
+// Sum returns the digest of the file computed while parsing. The second
+// return value reports whether the digest was computed, see
+// ParseOptions.Hashes.
+func (f *File) Sum(algorithm HashAlgorithm) ([]byte, bool) {
+	sum, ok := f.sums[algorithm]
+	return sum, ok
+}
+
+// SumHex returns the hex encoded digest of the file computed while parsing or
+// an empty string if the digest was not computed.
+func (f *File) SumHex(algorithm HashAlgorithm) string {
+	return hex.EncodeToString(f.sums[algorithm])
+}
+
+// VerifySum reports whether the digest of the file equals checksum, e.g. a
+// checksum supplied by the client. The checksum is either hex or base64
+// encoded. VerifySum returns false if the digest was not computed.
+func (f *File) VerifySum(algorithm HashAlgorithm, checksum string) bool {
+	sum, ok := f.sums[algorithm]
+	if !ok {
+		return false
+	}
+
+	expected, err := hex.DecodeString(strings.TrimSpace(checksum))
+	if err != nil || len(expected) != len(sum) {
+		expected, err = base64.StdEncoding.DecodeString(strings.TrimSpace(checksum))
+		if err != nil {
+			return false
+		}
+	}
+	return subtle.ConstantTimeCompare(sum, expected) == 1
+}
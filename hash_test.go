@@ -0,0 +1,86 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseHashes(t *testing.T) {
+	opts := ParseOptions{
+		MaxMemory: -1,
+		FileStore: NewMemoryStore(),
+		Hashes:    []HashAlgorithm{SHA256, SHA1, MD5, CRC32},
+	}
+	fd, err := ParseWithOptions(testRequestValidContentType(t), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// digests of "This is my second test file"
+	expected := map[HashAlgorithm]string{
+		SHA256: "ee3f856f37959a88193766edc1d85a3e4886d914fa962bab6fa14968408dc569",
+		SHA1:   "8ee95cb8e57ec49e0d26d56f90e12158b6549ee8",
+		MD5:    "390a290ffc4250761dde2704f8252cc3",
+		CRC32:  "245f5bfa",
+	}
+
+	f := fd.GetFile("attachment").First()
+	for algorithm, sum := range expected {
+		got := f.SumHex(algorithm)
+		if got != sum {
+			t.Errorf("Invalid %s: expected: %s, got: %s", algorithm, sum, got)
+		}
+	}
+
+	if !f.VerifySum(MD5, "390A290FFC4250761DDE2704F8252CC3") {
+		t.Errorf("Hex checksum not verified")
+	}
+	if !f.VerifySum(MD5, "OQopD/xCUHYd3icE+CUsww==") {
+		t.Errorf("Base64 checksum not verified")
+	}
+	if f.VerifySum(MD5, "00000000000000000000000000000000") {
+		t.Errorf("Invalid checksum verified")
+	}
+
+	fd, err = ParseWithOptions(testRequestValidContentType(t), ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fd.GetFile("attachment").First().Sum(SHA256); ok {
+		t.Errorf("Digest computed without being configured")
+	}
+	if fd.GetFile("attachment").First().VerifySum(SHA256, "") {
+		t.Errorf("Checksum verified without digest")
+	}
+
+	_, err = ParseWithOptions(testRequestValidContentType(t), ParseOptions{Hashes: []HashAlgorithm{"sha3"}})
+	if !errors.Is(err, ErrUnsupportedHash) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrUnsupportedHash, err)
+	}
+}
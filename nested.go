@@ -0,0 +1,331 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// KeyStyle defines the notation of nested keys.
+type KeyStyle int
+
+const (
+	// BracketKeys nests keys with brackets, e.g. "items[0][qty]". An empty
+	// pair of brackets like "tags[]" marks a list of values.
+	BracketKeys KeyStyle = 1 << iota
+
+	// DotKeys nests keys with dots, e.g. "items.0.qty".
+	DotKeys
+)
+
+// SetKeyStyle sets the notation of nested keys used by Tree, Path and
+// ValidatePath. If style is zero, both BracketKeys and DotKeys are used, which
+// is the default. ParseOptions.KeyStyle sets the style while parsing.
+func (fd *FormData) SetKeyStyle(style KeyStyle) {
+	fd.keyStyle = style
+}
+
+func (fd *FormData) style() KeyStyle {
+	if fd.keyStyle == 0 {
+		return BracketKeys | DotKeys
+	}
+	return fd.keyStyle
+}
+
+// Tree returns the values of FormData as nested tree according to the key
+// style. Keys like "address[city]" become maps, numeric keys like
+// "items[0][qty]" become slices ordered by index. Sparse indices like
+// "items[0]" and "items[2]" become maps keyed by index, so Tree and Path agree
+// on every index. Leaves are a string, or a []string for keys with multiple
+// values and lists like "tags[]".
+func (fd *FormData) Tree() map[string]interface{} {
+	root := &treeNode{}
+	for _, key := range sortedKeys(fd.Value) {
+		segments, list := splitKey(key, fd.style())
+		node := root
+		for _, segment := range segments {
+			node = node.child(segment)
+		}
+		node.values = append(node.values, fd.Value[key]...)
+		node.list = node.list || list
+	}
+	return root.mapValue()
+}
+
+// Path returns all values of the keys matching path, e.g. "items.0.qty" for
+// the key "items[0][qty]". Path segments are separated by dots regardless of
+// the key style, a "*" segment matches any segment, e.g. "items.*.qty". The
+// values are ordered by key, numeric segments by their number.
+func (fd *FormData) Path(path string) FormDataValue {
+	values := []string{}
+	for _, key := range matchingKeys(sortedKeys(fd.Value), path, fd.style()) {
+		values = append(values, fd.Value[key]...)
+	}
+	return values
+}
+
+// FilePath returns all files of the keys matching path like Path.
+func (fd *FormData) FilePath(path string) FormDataFile {
+	files := FormDataFile{}
+	for _, key := range matchingKeys(sortedFileKeys(fd.File), path, fd.style()) {
+		files = append(files, fd.GetFile(key)...)
+	}
+	return files
+}
+
+// ValidatePath creates a validation of all value keys matching path, see
+// Path.
+func (fd *FormData) ValidatePath(path string) *PathValidation {
+	return fd.validatePath(path, sortedKeys(fd.Value), false)
+}
+
+// ValidateFilePath creates a validation of all file keys matching path, see
+// Path.
+func (fd *FormData) ValidateFilePath(path string) *PathValidation {
+	return fd.validatePath(path, sortedFileKeys(fd.File), true)
+}
+
+func (fd *FormData) validatePath(path string, keys []string, isFile bool) *PathValidation {
+	pv := &PathValidation{data: fd, path: path}
+	for _, key := range matchingKeys(keys, path, fd.style()) {
+		pv.validations = append(pv.validations, fd.validate(key, isFile))
+	}
+	return pv
+}
+
+// PathValidation validates all keys matching a path, like "items.*.qty".
+// Every validation applies to each matching key separately.
+type PathValidation struct {
+	data        *FormData
+	path        string
+	validations []*Validation
+}
+
+// Required checks if at least one key matches the path.
+func (pv *PathValidation) Required() *PathValidation {
+	if len(pv.validations) == 0 {
+		pv.data.validate(pv.path, false).addRequiredError(pv.path)
+	}
+	return pv
+}
+
+// HasN validates if every matching key has the given number of elements.
+func (pv *PathValidation) HasN(count int) *PathValidation {
+	return pv.Each(func(v *Validation) { v.HasN(count) })
+}
+
+// HasNMin validates if every matching key has minimal N number of elements.
+func (pv *PathValidation) HasNMin(count int) *PathValidation {
+	return pv.Each(func(v *Validation) { v.HasNMin(count) })
+}
+
+// Match validates if the first element of every matching key matches the
+// given regular expression.
+func (pv *PathValidation) Match(regex *regexp.Regexp) *PathValidation {
+	return pv.Each(func(v *Validation) { v.Match(regex) })
+}
+
+// MatchAll validates if all elements of every matching key match the given
+// regular expression.
+func (pv *PathValidation) MatchAll(regex *regexp.Regexp) *PathValidation {
+	return pv.Each(func(v *Validation) { v.MatchAll(regex) })
+}
+
+// MatchEmail validates if the first element of every matching key matches an
+// email.
+func (pv *PathValidation) MatchEmail() *PathValidation {
+	return pv.Each(func(v *Validation) { v.MatchEmail() })
+}
+
+// MatchAllEmail validates if all elements of every matching key match an
+// email.
+func (pv *PathValidation) MatchAllEmail() *PathValidation {
+	return pv.Each(func(v *Validation) { v.MatchAllEmail() })
+}
+
+// Each calls fn with the validation of every matching key, which allows using
+// any validation of Validation.
+func (pv *PathValidation) Each(fn func(v *Validation)) *PathValidation {
+	for _, v := range pv.validations {
+		fn(v)
+	}
+	return pv
+}
+
+// splitKey splits key into its segments according to style. list reports
+// whether the key ends with an empty pair of brackets, which is removed.
+func splitKey(key string, style KeyStyle) (segments []string, list bool) {
+	var segment strings.Builder
+	started := true
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c == '[' && style&BracketKeys != 0:
+			end := strings.IndexByte(key[i:], ']')
+			if end < 0 {
+				segment.WriteString(key[i:])
+				i = len(key)
+				started = true
+				continue
+			}
+			if started {
+				segments = append(segments, segment.String())
+				segment.Reset()
+			}
+			segments = append(segments, key[i+1:i+end])
+			i += end
+			started = false
+		case c == '.' && style&DotKeys != 0:
+			if started {
+				segments = append(segments, segment.String())
+				segment.Reset()
+			}
+			started = true
+		default:
+			segment.WriteByte(c)
+			started = true
+		}
+	}
+	if started {
+		segments = append(segments, segment.String())
+	}
+
+	if n := len(segments); n > 1 && segments[n-1] == "" && strings.HasSuffix(key, "[]") {
+		return segments[:n-1], true
+	}
+	return segments, false
+}
+
+// matchingKeys returns the keys matching path, ordered by their segments.
+func matchingKeys(keys []string, path string, style KeyStyle) []string {
+	pattern := strings.Split(path, ".")
+	type match struct {
+		key      string
+		segments []string
+	}
+	var matches []match
+	for _, key := range keys {
+		segments, _ := splitKey(key, style)
+		if matchSegments(pattern, segments) {
+			matches = append(matches, match{key, segments})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return lessSegments(matches[i].segments, matches[j].segments)
+	})
+
+	matching := make([]string, len(matches))
+	for i, m := range matches {
+		matching[i] = m.key
+	}
+	return matching
+}
+
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lessSegments orders segments lexically, numeric segments by their number.
+func lessSegments(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		x, errX := strconv.Atoi(a[i])
+		y, errY := strconv.Atoi(b[i])
+		if errX == nil && errY == nil {
+			return x < y
+		}
+		return a[i] < b[i]
+	}
+	return len(a) < len(b)
+}
+
+// treeNode is a node of the tree returned by FormData.Tree.
+type treeNode struct {
+	values   []string
+	list     bool
+	children map[string]*treeNode
+}
+
+func (n *treeNode) child(segment string) *treeNode {
+	if n.children == nil {
+		n.children = make(map[string]*treeNode)
+	}
+	c, ok := n.children[segment]
+	if !ok {
+		c = &treeNode{}
+		n.children[segment] = c
+	}
+	return c
+}
+
+// value returns the leaf value or the children of n as map or slice.
+func (n *treeNode) value() interface{} {
+	if n.children == nil {
+		if n.list || len(n.values) != 1 {
+			return append([]string{}, n.values...)
+		}
+		return n.values[0]
+	}
+
+	// the children only form a slice if their segments are exactly the
+	// indices 0 to n-1, so the slice index always equals the path segment
+	s := make([]interface{}, len(n.children))
+	for segment, c := range n.children {
+		i, err := strconv.Atoi(segment)
+		if err != nil || i < 0 || i >= len(s) || strconv.Itoa(i) != segment || len(n.values) > 0 {
+			return n.mapValue()
+		}
+		s[i] = c.value()
+	}
+	return s
+}
+
+// mapValue returns the children of n as map. Values of n itself, of a key
+// which also has nested keys, are stored under the empty key.
+func (n *treeNode) mapValue() map[string]interface{} {
+	m := make(map[string]interface{}, len(n.children)+1)
+	for segment, c := range n.children {
+		m[segment] = c.value()
+	}
+	if len(n.values) > 0 {
+		m[""] = (&treeNode{values: n.values, list: n.list}).value()
+	}
+	return m
+}
@@ -0,0 +1,164 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"mime/multipart"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestSplitKey(t *testing.T) {
+	testcases := []struct {
+		key      string
+		style    KeyStyle
+		expected []string
+		list     bool
+	}{
+		{"name", BracketKeys | DotKeys, []string{"name"}, false},
+		{"address[city]", BracketKeys | DotKeys, []string{"address", "city"}, false},
+		{"items[0][qty]", BracketKeys | DotKeys, []string{"items", "0", "qty"}, false},
+		{"items.0.qty", BracketKeys | DotKeys, []string{"items", "0", "qty"}, false},
+		{"items[0].qty", BracketKeys | DotKeys, []string{"items", "0", "qty"}, false},
+		{"tags[]", BracketKeys | DotKeys, []string{"tags"}, true},
+		{"items.0.qty", BracketKeys, []string{"items.0.qty"}, false},
+		{"address[city]", DotKeys, []string{"address[city]"}, false},
+		{"broken[key", BracketKeys, []string{"broken[key"}, false},
+	}
+
+	for _, testcase := range testcases {
+		segments, list := splitKey(testcase.key, testcase.style)
+		if !reflect.DeepEqual(segments, testcase.expected) || list != testcase.list {
+			t.Errorf("Invalid segments of %q: expected: %q %t, got: %q %t", testcase.key, testcase.expected, testcase.list, segments, list)
+		}
+	}
+}
+
+func testNestedFormData() *FormData {
+	fd := emptyFormData()
+	fd.Value["name"] = []string{"neox5"}
+	fd.Value["address[city]"] = []string{"Vienna"}
+	fd.Value["address[zip]"] = []string{"1010"}
+	fd.Value["tags[]"] = []string{"go", "form"}
+	fd.Value["items[0][qty]"] = []string{"1"}
+	fd.Value["items[0][sku]"] = []string{"A-1"}
+	fd.Value["items[1][qty]"] = []string{"x"}
+	fd.Value["items[10][qty]"] = []string{"3"}
+	fd.File["docs[0]"] = []*multipart.FileHeader{{Filename: "a.pdf"}}
+	fd.File["docs[1]"] = []*multipart.FileHeader{{Filename: "b.pdf"}}
+	return fd
+}
+
+func TestTree(t *testing.T) {
+	expected := map[string]interface{}{
+		"name":    "neox5",
+		"address": map[string]interface{}{"city": "Vienna", "zip": "1010"},
+		"tags":    []string{"go", "form"},
+		// sparse indices become a map keyed by index
+		"items": map[string]interface{}{
+			"0":  map[string]interface{}{"qty": "1", "sku": "A-1"},
+			"1":  map[string]interface{}{"qty": "x"},
+			"10": map[string]interface{}{"qty": "3"},
+		},
+	}
+	if got := testNestedFormData().Tree(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Invalid tree: expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestTreeIndices(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["items[0][qty]"] = []string{"1"}
+	fd.Value["items[1][qty]"] = []string{"2"}
+	fd.Value["items[2][qty]"] = []string{"3"}
+	fd.Value["sparse[0]"] = []string{"a"}
+	fd.Value["sparse[2]"] = []string{"b"}
+	fd.Value["sparse[10]"] = []string{"c"}
+
+	expected := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"qty": "1"},
+			map[string]interface{}{"qty": "2"},
+			map[string]interface{}{"qty": "3"},
+		},
+		"sparse": map[string]interface{}{"0": "a", "2": "b", "10": "c"},
+	}
+	tree := fd.Tree()
+	if !reflect.DeepEqual(tree, expected) {
+		t.Errorf("Invalid tree: expected: %v, got: %v", expected, tree)
+	}
+	sparse := tree["sparse"].(map[string]interface{})
+	if got, path := sparse["2"], fd.Path("sparse.2").First(); got != path {
+		t.Errorf("Tree and Path disagree on index 2: expected: %v, got: %v", path, got)
+	}
+}
+
+func TestPath(t *testing.T) {
+	fd := testNestedFormData()
+	testcases := []struct {
+		path     string
+		expected []string
+	}{
+		{"name", []string{"neox5"}},
+		{"address.city", []string{"Vienna"}},
+		{"tags", []string{"go", "form"}},
+		{"items.0.qty", []string{"1"}},
+		{"items.*.qty", []string{"1", "x", "3"}},
+		{"items.*", []string{}},
+		{"unknown", []string{}},
+	}
+	for _, testcase := range testcases {
+		if got := fd.Path(testcase.path); !reflect.DeepEqual([]string(got), testcase.expected) {
+			t.Errorf("Invalid values of %q: expected: %v, got: %v", testcase.path, testcase.expected, got)
+		}
+	}
+
+	if got := fd.FilePath("docs.*"); len(got) != 2 || got.At(1).Filename != "b.pdf" {
+		t.Errorf("Invalid files: got: %d", len(got))
+	}
+
+	fd.SetKeyStyle(DotKeys)
+	if got := fd.Path("items.0.qty"); len(got) != 0 {
+		t.Errorf("Bracket key matched dot style: got: %v", got)
+	}
+}
+
+func TestValidatePath(t *testing.T) {
+	fd := testNestedFormData()
+	fd.ValidatePath("items.*.qty").Required().HasN(1).Match(regexp.MustCompile(`^\d+$`))
+	fd.ValidatePath("items.*.price").Required()
+	fd.ValidateFilePath("docs.*").Required().HasN(1)
+
+	expected := []string{
+		"'items[1][qty]': does not match: ^\\d+$",
+		"'items.*.price': is required",
+	}
+	if got := fd.Errors(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Invalid errors: expected: %v, got: %v", expected, got)
+	}
+}
@@ -27,6 +27,11 @@
 package formdata
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"strings"
 )
@@ -42,25 +47,230 @@ func Parse(r *http.Request) (*FormData, error) {
 	return ParseMax(r, DefaultParseMaxMemory)
 }
 
+// ParseAny parses a request body either as multipart/form-data or as
+// application/x-www-form-urlencoded, in which case FormData.File is empty.
+// ParseAny envokes ParseWithOptions with AllowURLEncoded set.
+func ParseAny(r *http.Request) (*FormData, error) {
+	return ParseWithOptions(r, ParseOptions{AllowURLEncoded: true})
+}
+
 // ParseMax parses a request body as multipart/form-data. The whole
 // request body is parsed and up to a total of maxMemory bytes of its file parts
 // are stored in memory, with the remainder stored on disk in temporary files.
 //
 // To limit the size of the incoming request set http.MaxBytesReader before
-// parsing.
+// parsing or use ParseWithOptions.
 func ParseMax(r *http.Request, maxMemory int64) (*FormData, error) {
-	contentType := r.Header.Get("Content-Type")
-
-	if !strings.HasPrefix(contentType, "multipart/form-data") {
+	if !isMultipartFormData(r.Header.Get("Content-Type")) {
 		return nil, ErrNotMultipartFormData
 	}
 
 	if err := r.ParseMultipartForm(maxMemory); err != nil {
-		return nil, err
+		return nil, newParseError(err, -1, -1)
 	}
 
 	return &FormData{
-		r.MultipartForm,
-		make([]*ValidationError, 0),
+		Form:   r.MultipartForm,
+		errors: make([]*ValidationError, 0),
+		files:  make(map[*multipart.FileHeader]*File),
 	}, nil
 }
+
+// PartHandler is called by ParseStream for every part of the request body in
+// the order the parts arrive. fd holds all values parsed so far.
+//
+// Value parts are read and added to fd.Value before the handler is called,
+// therefore the content of p is already consumed. File parts are not stored,
+// the handler is responsible for reading their content. Unread content is
+// discarded. Returning a non-nil error aborts parsing.
+type PartHandler func(fd *FormData, p *StreamPart) error
+
+// StreamPart is a part handed to a PartHandler. It describes the part like
+// the last element of FormData.Parts, including its decoded filename and the
+// encodings it was sent with. Reading a StreamPart returns the decoded
+// content, see Part.TransferEncoding and Part.ContentEncoding.
+type StreamPart struct {
+	*Part
+
+	r io.Reader
+}
+
+// Read reads the decoded content of the part.
+func (p *StreamPart) Read(b []byte) (int, error) {
+	return p.r.Read(b)
+}
+
+// ParseStream parses a request body as multipart/form-data part by part
+// without buffering the whole body. Every part is handed to handler as soon as
+// it arrives, which allows piping files directly to their destination and
+// aborting early on invalid values.
+//
+// The returned FormData contains all values of the body, FormData.File stays
+// empty. If handler returns an error, parsing stops and the error is returned.
+func ParseStream(r *http.Request, handler PartHandler) (*FormData, error) {
+	if !isMultipartFormData(r.Header.Get("Content-Type")) {
+		return nil, ErrNotMultipartFormData
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, parseErrorKind(err)
+	}
+
+	if handler == nil {
+		handler = func(*FormData, *StreamPart) error { return nil }
+	}
+	return newParser(reader, ParseOptions{}, handler).parse()
+}
+
+// ParseWithOptions parses a request body as multipart/form-data and enforces
+// the limits of opts while reading. Up to a total of opts.MaxMemory bytes of
+// its file parts are stored in memory, with the remainder stored on disk in
+// temporary files.
+//
+// If a limit is exceeded, parsing stops and the corresponding error, like
+// ErrTooManyParts or ErrFileTooLarge, is returned. A body sent slower than
+// opts.MinBytesPerSecond or idle for longer than opts.MaxIdleTime fails with
+// a *SlowUploadError.
+//
+// If opts.AllowURLEncoded is set, application/x-www-form-urlencoded bodies are
+// accepted as well.
+func ParseWithOptions(r *http.Request, opts ParseOptions) (*FormData, error) {
+	return parseRequest(context.Background(), r, opts)
+}
+
+// parseRequest parses the body of r until ctx is done.
+func parseRequest(ctx context.Context, r *http.Request, opts ParseOptions) (*FormData, error) {
+	fd, err := parseBody(ctx, r.Header.Get("Content-Type"), r.Body, r.ContentLength, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.AutoClose {
+		fd.CloseOnDone(r.Context())
+	}
+	return fd, nil
+}
+
+// ParseReader parses r as multipart/form-data body with the given boundary and
+// enforces the limits of opts like ParseWithOptions. It allows parsing
+// multipart/form-data outside of net/http, e.g. from message queues or stored
+// requests. opts.AutoClose and opts.AllowURLEncoded have no effect.
+func ParseReader(r io.Reader, boundary string, opts ParseOptions) (*FormData, error) {
+	if boundary == "" {
+		return nil, ErrMissingBoundary
+	}
+	if !validBoundary(boundary) {
+		return nil, ErrMalformedBoundary
+	}
+	return readBody(context.Background(), r, -1, boundary, opts)
+}
+
+// ParseBytes parses body as multipart/form-data with the boundary of the given
+// Content-Type header value. ParseBytes enforces no limits, it is meant for
+// bodies which are already in memory.
+func ParseBytes(contentType string, body []byte) (*FormData, error) {
+	return parseBody(context.Background(), contentType, bytes.NewReader(body), int64(len(body)), ParseOptions{})
+}
+
+// parseBody parses body according to its Content-Type. contentLength is the
+// expected size of body reported to opts.Progress, -1 if it is unknown.
+func parseBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, opts ParseOptions) (*FormData, error) {
+	if opts.AllowURLEncoded && isURLEncoded(contentType) {
+		// the charset of the request takes precedence over DefaultCharset
+		if _, params, err := mime.ParseMediaType(contentType); err == nil && params["charset"] != "" {
+			opts.DefaultCharset = params["charset"]
+		}
+		return readBody(ctx, body, contentLength, "", opts)
+	}
+	if !isMultipartFormData(contentType) {
+		return nil, ErrNotMultipartFormData
+	}
+
+	boundary, err := multipartBoundary(contentType)
+	if err != nil {
+		return nil, err
+	}
+	return readBody(ctx, body, contentLength, boundary, opts)
+}
+
+// readBody reads body until ctx is done and parses it as multipart/form-data
+// with the given boundary, or as application/x-www-form-urlencoded if boundary
+// is empty.
+func readBody(ctx context.Context, body io.Reader, contentLength int64, boundary string, opts ParseOptions) (*FormData, error) {
+	cr := newContextReader(ctx, body, opts)
+	if cr != nil {
+		body = cr
+	}
+	body, progress := withProgress(body, contentLength, opts)
+	defer progress.finish()
+
+	var fd *FormData
+	var err error
+	if boundary == "" {
+		fd, err = parseURLEncoded(body, opts)
+	} else {
+		fd, err = parseMultipart(body, boundary, opts, progress)
+	}
+	if err != nil {
+		return nil, cr.abortError(err)
+	}
+	fd.keyStyle = opts.KeyStyle
+	fd.applyDuplicatePolicies(opts)
+	if opts.Strict != nil {
+		fd.ValidateStrict(*opts.Strict)
+	}
+	return fd, nil
+}
+
+// parseMultipart parses r as multipart/form-data body with the given boundary.
+// progress may be nil.
+func parseMultipart(r io.Reader, boundary string, opts ParseOptions, progress *progressReader) (*FormData, error) {
+	body := &countReader{r: r}
+	r = body
+	if opts.MaxTotalBytes > 0 {
+		r = newLimitReader(r, opts.MaxTotalBytes, ErrBodyTooLarge)
+	}
+	p := newParser(multipart.NewReader(r, boundary), opts, nil)
+	p.progress = progress
+	p.body = body
+	return p.parse()
+}
+
+func isMultipartFormData(contentType string) bool {
+	return strings.HasPrefix(contentType, "multipart/form-data")
+}
+
+func multipartBoundary(contentType string) (string, error) {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", ErrMalformedBoundary
+	}
+	boundary, ok := params["boundary"]
+	if !ok {
+		return "", ErrMissingBoundary
+	}
+	if !validBoundary(boundary) {
+		return "", ErrMalformedBoundary
+	}
+	return boundary, nil
+}
+
+// validBoundary reports whether boundary follows RFC 2046: 1 to 70
+// characters, not ending with a space.
+func validBoundary(boundary string) bool {
+	if len(boundary) < 1 || len(boundary) > 70 || boundary[len(boundary)-1] == ' ' {
+		return false
+	}
+	for _, b := range boundary {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9':
+			continue
+		}
+		switch b {
+		case '\'', '(', ')', '+', '_', ',', '-', '.', '/', ':', '=', '?', ' ':
+			continue
+		}
+		return false
+	}
+	return true
+}
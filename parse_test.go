@@ -28,11 +28,19 @@ package formdata
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
+	"io/ioutil"
+	"math"
 	"math/rand"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestParse(t *testing.T) {
@@ -47,6 +55,376 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseStream(t *testing.T) {
+	var filenames []string
+	var size int
+	fd, err := ParseStream(testRequestValidContentType(t), func(fd *FormData, p *StreamPart) error {
+		if !p.IsFile {
+			if !fd.Exists(p.Name) {
+				t.Errorf("Value %s was not parsed before calling the handler", p.Name)
+			}
+			return nil
+		}
+		b, err := ioutil.ReadAll(p)
+		if err != nil {
+			return err
+		}
+		filenames = append(filenames, p.Filename)
+		size += len(b)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(filenames) != 2 {
+		t.Errorf("Invalid number of handled files: expected: 2, got: %d", len(filenames))
+	}
+	if size != 27+50*1024 {
+		t.Errorf("Invalid size of handled files: expected: %d, got: %d", 27+50*1024, size)
+	}
+	if len(fd.Get("to")) != 2 || fd.Get("subject").First() != "Updates on Example project" {
+		t.Errorf("Values not parsed: got: %v", fd.Value)
+	}
+	if len(fd.File) != 0 {
+		t.Errorf("Files should not be stored: got: %d", len(fd.File))
+	}
+
+	errAbort := errors.New("abort")
+	_, err = ParseStream(testRequestValidContentType(t), func(fd *FormData, p *StreamPart) error {
+		return errAbort
+	})
+	if err != errAbort {
+		t.Errorf("Handler error not returned: expected: %v, got: %v", errAbort, err)
+	}
+
+	_, err = ParseStream(testRequestInvalidContentType(t), nil)
+	if err != ErrNotMultipartFormData {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrNotMultipartFormData, err)
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	testcases := []struct {
+		opts     ParseOptions
+		expected error
+	}{
+		{ParseOptions{}, nil},
+		{ParseOptions{MaxTotalBytes: 1 << 20, MaxFileBytes: 50 * 1024, MaxValueBytes: 64, MaxParts: 7, MaxFiles: 2, MaxKeyLength: 10}, nil},
+		{ParseOptions{MaxTotalBytes: 100}, ErrBodyTooLarge},
+		{ParseOptions{MaxFileBytes: 1024}, ErrFileTooLarge},
+		{ParseOptions{MaxValueBytes: 10}, ErrValueTooLarge},
+		{ParseOptions{MaxParts: 3}, ErrTooManyParts},
+		{ParseOptions{MaxFiles: 1}, ErrTooManyFiles},
+		{ParseOptions{MaxKeyLength: 3}, ErrKeyTooLong},
+	}
+
+	for _, testcase := range testcases {
+		fd, err := ParseWithOptions(testRequestValidContentType(t), testcase.opts)
+		if !errors.Is(err, testcase.expected) {
+			t.Errorf("Invalid error for %+v: expected: %v, got: %v", testcase.opts, testcase.expected, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(fd.Get("to")) != 2 || len(fd.GetFile("attachment")) != 2 {
+			t.Errorf("Form-data not parsed for %+v: got: %v", testcase.opts, fd.Value)
+		}
+		testFileContent(t, fd.GetFile("attachment").First(), "This is my second test file")
+	}
+
+	_, err := ParseWithOptions(testRequestInvalidContentType(t), ParseOptions{})
+	if err != ErrNotMultipartFormData {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrNotMultipartFormData, err)
+	}
+}
+
+func TestParseWithOptionsMaxInt64(t *testing.T) {
+	opts := ParseOptions{
+		MaxMemory:            math.MaxInt64,
+		MaxTotalBytes:        math.MaxInt64,
+		MaxFileBytes:         math.MaxInt64,
+		MaxValueBytes:        math.MaxInt64,
+		MaxDecompressedBytes: math.MaxInt64,
+	}
+
+	fd, err := ParseWithOptions(testRequestValidContentType(t), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range fd.GetFile("attachment") {
+		if f.Size == 0 || f.content == nil {
+			t.Errorf("File %s not stored in memory: got size: %d", f.Filename, f.Size)
+		}
+	}
+	testFileContent(t, fd.GetFile("attachment").First(), "This is my second test file")
+
+	body, boundary := testEncodedBody(t, "data.txt", "", "gzip", []byte("compressed"))
+	fd, err = ParseReader(bytes.NewReader(body), boundary, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFileContent(t, fd.GetFile("field").First(), "compressed")
+}
+
+func TestParseWithOptionsTempFiles(t *testing.T) {
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{MaxMemory: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := fd.GetFile("attachment")
+	for _, f := range files {
+		if f.store != defaultFileStore || f.key == "" {
+			t.Errorf("File %s not stored in temporary file", f.Filename)
+		}
+	}
+	testFileContent(t, files.First(), "This is my second test file")
+
+	if err := fd.RemoveAll(); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if _, err := f.Open(); !os.IsNotExist(err) {
+			t.Errorf("Temporary file %s not removed: got: %v", f.key, err)
+		}
+	}
+}
+
+func TestParseWithOptionsFileStore(t *testing.T) {
+	store := NewMemoryStore()
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{MaxMemory: -1, FileStore: store})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.Len() != 2 {
+		t.Errorf("Invalid number of stored files: expected: 2, got: %d", store.Len())
+	}
+	testFileContent(t, fd.GetFile("attachment").First(), "This is my second test file")
+
+	if err := fd.RemoveAll(); err != nil {
+		t.Fatal(err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Files not removed from store: got: %d", store.Len())
+	}
+
+	// files of failed requests are removed
+	_, err = ParseWithOptions(testRequestValidContentType(t), ParseOptions{MaxMemory: -1, FileStore: store, MaxKeyLength: 3})
+	if !errors.Is(err, ErrKeyTooLong) {
+		t.Fatalf("Invalid error: expected: %v, got: %v", ErrKeyTooLong, err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Files of failed request not removed from store: got: %d", store.Len())
+	}
+}
+
+func TestParseWithOptionsAutoClose(t *testing.T) {
+	store := NewMemoryStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	r := testRequestValidContentType(t).WithContext(ctx)
+
+	_, err := ParseWithOptions(r, ParseOptions{MaxMemory: -1, FileStore: store, AutoClose: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.Len() != 2 {
+		t.Fatalf("Invalid number of stored files: expected: 2, got: %d", store.Len())
+	}
+
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for store.Len() != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Files not removed after context is done: got: %d", store.Len())
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	fd, err := ParseAny(testRequestValidContentType(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fd.Get("to")) != 2 || len(fd.GetFile("attachment")) != 2 {
+		t.Errorf("Form-data not parsed: got: %v", fd.Value)
+	}
+
+	fd, err = ParseAny(testRequestURLEncoded(t, "to=a%40example.com&to=b%40example.com&subject=hello+world&empty="))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(fd.Get("to"), ","); got != "a@example.com,b@example.com" {
+		t.Errorf("Invalid value for to: expected: %s, got: %s", "a@example.com,b@example.com", got)
+	}
+	if got := fd.Get("subject").First(); got != "hello world" {
+		t.Errorf("Invalid value for subject: expected: %s, got: %s", "hello world", got)
+	}
+	if !fd.Exists("empty") || fd.FileExists("empty") {
+		t.Errorf("Key empty should exist as value only")
+	}
+	fd.Validate("to").Required().HasN(2).MatchAllEmail()
+	if fd.HasErrors() {
+		t.Errorf("Validation errors: got: %s", strings.Join(fd.Errors(), " "))
+	}
+
+	_, err = ParseWithOptions(testRequestURLEncoded(t, "a=1&b=2&c=3"), ParseOptions{AllowURLEncoded: true, MaxParts: 2})
+	if !errors.Is(err, ErrTooManyParts) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrTooManyParts, err)
+	}
+
+	_, err = Parse(testRequestURLEncoded(t, "a=1"))
+	if err != ErrNotMultipartFormData {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrNotMultipartFormData, err)
+	}
+
+	_, err = ParseAny(testRequestInvalidContentType(t))
+	if err != ErrNotMultipartFormData {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrNotMultipartFormData, err)
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	r, boundary := testRequestWithMultipartForm(t)
+
+	fd, err := ParseReader(r.Body, boundary, ParseOptions{MaxFiles: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fd.Get("to")) != 2 || len(fd.GetFile("attachment")) != 2 {
+		t.Errorf("Form-data not parsed: got: %v", fd.Value)
+	}
+	testFileContent(t, fd.GetFile("attachment").First(), "This is my second test file")
+
+	r, boundary = testRequestWithMultipartForm(t)
+	if _, err := ParseReader(r.Body, boundary, ParseOptions{MaxFiles: 1}); !errors.Is(err, ErrTooManyFiles) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrTooManyFiles, err)
+	}
+
+	if _, err := ParseReader(r.Body, "", ParseOptions{}); err != ErrMissingBoundary {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrMissingBoundary, err)
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	r, boundary := testRequestWithMultipartForm(t)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fd, err := ParseBytes("multipart/form-data; boundary="+boundary, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Validate("to").Required().HasN(2).MatchAllEmail()
+	if fd.HasErrors() {
+		t.Errorf("Validation errors: got: %s", strings.Join(fd.Errors(), " "))
+	}
+	testFileContent(t, fd.GetFile("attachment").First(), "This is my second test file")
+
+	if _, err := ParseBytes("multipart/form-data", body); err != ErrMissingBoundary {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrMissingBoundary, err)
+	}
+	if _, err := ParseBytes("application/json", body); err != ErrNotMultipartFormData {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrNotMultipartFormData, err)
+	}
+}
+
+func TestParseParts(t *testing.T) {
+	body := bytes.NewBuffer([]byte{})
+	multipartWriter := multipart.NewWriter(body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="first"`)
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Custom", "custom")
+	w, err := multipartWriter.CreatePart(header)
+	if err != nil {
+		t.Fatalf("CreatePart: %v", err)
+	}
+	w.Write([]byte(`{"a":1}`))
+	fileWriter, err := multipartWriter.CreateFormFile("second", "second.txt")
+	if err != nil {
+		t.Fatalf("CreateFormFile: %v", err)
+	}
+	fileWriter.Write([]byte("file"))
+	if err := multipartWriter.WriteField("first", "again"); err != nil {
+		t.Fatalf("WriteField: %v", err)
+	}
+	multipartWriter.Close()
+
+	fd, err := ParseBytes(multipartWriter.FormDataContentType(), body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Part{
+		{Name: "first", Index: 0},
+		{Name: "second", Filename: "second.txt", Index: 1, IsFile: true},
+		{Name: "first", Index: 2},
+	}
+	parts := fd.Parts()
+	if len(parts) != len(expected) {
+		t.Fatalf("Invalid number of parts: expected: %d, got: %d", len(expected), len(parts))
+	}
+	for i, part := range parts {
+		e := expected[i]
+		if part.Name != e.Name || part.Filename != e.Filename || part.Index != e.Index || part.IsFile != e.IsFile {
+			t.Errorf("Invalid part at %d: expected: %+v, got: %+v", i, e, *part)
+		}
+	}
+	if got := parts[0].Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Invalid Content-Type of value part: expected: %s, got: %s", "application/json", got)
+	}
+	if got := parts[0].Header.Get("X-Custom"); got != "custom" {
+		t.Errorf("Invalid X-Custom header of value part: expected: %s, got: %s", "custom", got)
+	}
+
+	fd, err = ParseAny(testRequestURLEncoded(t, "b=1&a=2&b=3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, part := range fd.Parts() {
+		names = append(names, part.Name)
+	}
+	if got := strings.Join(names, ","); got != "b,a,b" {
+		t.Errorf("Invalid order of urlencoded parts: expected: %s, got: %s", "b,a,b", got)
+	}
+}
+
+func testRequestURLEncoded(t *testing.T, body string) *http.Request {
+	t.Helper()
+	r, err := http.NewRequest("POST", "/", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("http.NewRequest: %v", err)
+	}
+	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func testFileContent(t *testing.T, f *File, expected string) {
+	t.Helper()
+
+	file, err := f.Open()
+	if err != nil {
+		t.Fatalf("Open %s: %v", f.Filename, err)
+	}
+	defer file.Close()
+
+	got, err := ioutil.ReadAll(file)
+	if err != nil {
+		t.Fatalf("ReadAll %s: %v", f.Filename, err)
+	}
+	if string(got) != expected {
+		t.Errorf("Invalid content of %s: expected: %q, got: %q", f.Filename, expected, got)
+	}
+}
+
 func testRequestValidContentType(t *testing.T) *http.Request {
 	t.Helper()
 	r, boundary := testRequestWithMultipartForm(t)
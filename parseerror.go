@@ -26,6 +26,17 @@
 
 package formdata
 
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
 // FormDataError represents an error working with multipart/form-data.
 type FormDataError struct {
 	ErrorString string
@@ -35,6 +46,184 @@ func (fe *FormDataError) Error() string { return fe.ErrorString }
 
 var (
 	// ErrNotMultipartFormData is returned by the Parse method to indicate that
-	// the parsed request has a differnt Content-Type than multipart/form-data.
+	// the parsed request has a differnt Content-Type than multipart/form-data,
+	// or application/x-www-form-urlencoded if enabled.
 	ErrNotMultipartFormData = &FormDataError{"request Content-Type isn't multipart/form-data"}
+
+	// ErrMissingBoundary is returned if the multipart/form-data Content-Type
+	// has no boundary parameter.
+	ErrMissingBoundary = &FormDataError{"no multipart boundary param in Content-Type"}
+
+	// ErrMalformedBoundary is returned if the Content-Type cannot be parsed or
+	// its boundary parameter is not a valid multipart boundary.
+	ErrMalformedBoundary = &FormDataError{"malformed multipart boundary"}
+
+	// ErrTruncatedBody is returned if the request body ends before its
+	// closing boundary.
+	ErrTruncatedBody = &FormDataError{"truncated request body"}
+
+	// ErrMalformedBody is returned if the request body does not follow the
+	// multipart format, e.g. a boundary is followed by garbage.
+	ErrMalformedBody = &FormDataError{"malformed request body"}
+
+	// ErrInvalidPartHeader is returned if the header of a part cannot be
+	// parsed.
+	ErrInvalidPartHeader = &FormDataError{"invalid part header"}
+
+	// ErrBodyTooLarge is returned by ParseWithOptions if the request body
+	// exceeds ParseOptions.MaxTotalBytes, or if the values of the request body
+	// exceed the memory limit of the parser.
+	ErrBodyTooLarge = &FormDataError{"request body too large"}
+
+	// ErrFileTooLarge is returned by ParseWithOptions if a file part exceeds
+	// ParseOptions.MaxFileBytes.
+	ErrFileTooLarge = &FormDataError{"file too large"}
+
+	// ErrValueTooLarge is returned by ParseWithOptions if a value part exceeds
+	// ParseOptions.MaxValueBytes.
+	ErrValueTooLarge = &FormDataError{"value too large"}
+
+	// ErrTooManyParts is returned by ParseWithOptions if the request body has
+	// more parts than ParseOptions.MaxParts.
+	ErrTooManyParts = &FormDataError{"too many parts"}
+
+	// ErrTooManyFiles is returned by ParseWithOptions if the request body has
+	// more file parts than ParseOptions.MaxFiles.
+	ErrTooManyFiles = &FormDataError{"too many files"}
+
+	// ErrKeyTooLong is returned by ParseWithOptions if the form-data key of a
+	// part is longer than ParseOptions.MaxKeyLength.
+	ErrKeyTooLong = &FormDataError{"key too long"}
+
+	// ErrUnsupportedCharset is returned by ParseWithOptions if a value part
+	// uses a charset which cannot be decoded.
+	ErrUnsupportedCharset = &FormDataError{"unsupported charset"}
+
+	// ErrInvalidUTF8 is returned by ParseWithOptions if a UTF-8 value part
+	// contains invalid UTF-8 and ParseOptions.RejectInvalidUTF8 is set.
+	ErrInvalidUTF8 = &FormDataError{"invalid UTF-8"}
+
+	// ErrUnsupportedEncoding is returned by ParseWithOptions if a part uses
+	// an unknown Content-Transfer-Encoding or Content-Encoding.
+	ErrUnsupportedEncoding = &FormDataError{"unsupported part encoding"}
+
+	// ErrDecompressedTooLarge is returned by ParseWithOptions if a compressed
+	// part exceeds ParseOptions.MaxDecompressedBytes after decompression.
+	ErrDecompressedTooLarge = &FormDataError{"decompressed part too large"}
+
+	// ErrUnsupportedHash is returned by ParseWithOptions if
+	// ParseOptions.Hashes contains an unsupported HashAlgorithm.
+	ErrUnsupportedHash = &FormDataError{"unsupported hash algorithm"}
 )
+
+// ParseError is returned if parsing a request body fails. It records where
+// parsing failed, errors.Is and errors.As report the cause:
+//
+//	var pe *formdata.ParseError
+//	if errors.Is(err, formdata.ErrFileTooLarge) && errors.As(err, &pe) {
+//		log.Printf("part %d too large", pe.Part)
+//	}
+type ParseError struct {
+	// Err is one of the Err* errors of this package or the error returned by
+	// the underlying reader.
+	Err error
+
+	// Part is the index of the part which failed, -1 if the failure is not
+	// related to a part.
+	Part int
+
+	// Offset is the number of bytes read from the request body when parsing
+	// failed, -1 if it is unknown. The body is read ahead in chunks, so Offset
+	// may exceed the position of the failure by a few KiB.
+	Offset int64
+
+	// cause is the original error if it was translated into Err
+	cause error
+}
+
+// newParseError returns a *ParseError for err, translating errors of the
+// standard library into the errors of this package.
+func newParseError(err error, part int, offset int64) error {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		return pe
+	}
+	kind := parseErrorKind(err)
+	pe = &ParseError{Err: kind, Part: part, Offset: offset}
+	if kind != err {
+		pe.cause = err
+	}
+	return pe
+}
+
+func (e *ParseError) Error() string {
+	switch {
+	case e.Part >= 0 && e.Offset >= 0:
+		return fmt.Sprintf("part %d at byte %d: %s", e.Part, e.Offset, e.Err)
+	case e.Part >= 0:
+		return fmt.Sprintf("part %d: %s", e.Part, e.Err)
+	case e.Offset >= 0:
+		return fmt.Sprintf("byte %d: %s", e.Offset, e.Err)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns Err.
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// Is reports whether the original error, e.g. io.ErrUnexpectedEOF for
+// ErrTruncatedBody, matches target.
+func (e *ParseError) Is(target error) bool {
+	return e.cause != nil && errors.Is(e.cause, target)
+}
+
+// parseErrorKind translates err into the corresponding error of this package
+// or returns err itself.
+func parseErrorKind(err error) error {
+	var fe *FormDataError
+	var pe textproto.ProtocolError
+	switch {
+	case errors.As(err, &fe):
+		return fe
+	case errors.Is(err, http.ErrMissingBoundary):
+		return ErrMissingBoundary
+	case errors.Is(err, http.ErrNotMultipart):
+		return ErrNotMultipartFormData
+	case errors.Is(err, multipart.ErrMessageTooLarge),
+		strings.Contains(err.Error(), "http: request body too large"):
+		return ErrBodyTooLarge
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		return ErrTruncatedBody
+	case errors.As(err, &pe),
+		strings.Contains(err.Error(), "malformed MIME header"):
+		return ErrInvalidPartHeader
+	case strings.Contains(err.Error(), "multipart: expecting a new Part"):
+		return ErrMalformedBody
+	}
+	return err
+}
+
+// SlowUploadError is returned by ParseWithOptions if the request body is sent
+// slower than ParseOptions.MinBytesPerSecond or stays idle for longer than
+// ParseOptions.MaxIdleTime.
+type SlowUploadError struct {
+	// BytesRead is the number of bytes read before parsing was aborted.
+	BytesRead int64
+
+	// Elapsed is the time spent waiting for the body.
+	Elapsed time.Duration
+
+	// Idle reports whether MaxIdleTime was exceeded, otherwise the average
+	// rate dropped below MinBytesPerSecond.
+	Idle bool
+}
+
+func (e *SlowUploadError) Error() string {
+	if e.Idle {
+		return fmt.Sprintf("upload idle: %d bytes in %s", e.BytesRead, e.Elapsed)
+	}
+	return fmt.Sprintf("upload too slow: %d bytes in %s", e.BytesRead, e.Elapsed)
+}
+
+// Timeout reports that the error is a timeout, like net.Error.
+func (e *SlowUploadError) Timeout() bool { return true }
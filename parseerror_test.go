@@ -0,0 +1,98 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	const value = "--b\r\nContent-Disposition: form-data; name=\"a\"\r\n\r\nvalue\r\n"
+	testcases := []struct {
+		contentType string
+		body        string
+		expected    error
+		part        int
+	}{
+		{"multipart/form-data", value + "--b--\r\n", ErrMissingBoundary, -1},
+		{"multipart/form-data; boundary=\"b", value + "--b--\r\n", ErrMalformedBoundary, -1},
+		{"multipart/form-data; boundary=\"b<\"", value + "--b--\r\n", ErrMalformedBoundary, -1},
+		{"multipart/form-data; boundary=" + strings.Repeat("b", 71), value, ErrMalformedBoundary, -1},
+		{"multipart/form-data; boundary=b", "", ErrTruncatedBody, 0},
+		{"multipart/form-data; boundary=b", value, ErrTruncatedBody, 0},
+		{"multipart/form-data; boundary=b", value + "--b\r\nContent-Disposition: form-data; name=\"b\"\r\n\r\nval", ErrTruncatedBody, 1},
+		{"multipart/form-data; boundary=b", value + "--b\r\nContent-Disposition form-data\r\n\r\nx\r\n--b--\r\n", ErrInvalidPartHeader, 1},
+	}
+
+	for _, testcase := range testcases {
+		_, err := ParseBytes(testcase.contentType, []byte(testcase.body))
+		if !errors.Is(err, testcase.expected) {
+			t.Errorf("Invalid error for %q: expected: %v, got: %v", testcase.body, testcase.expected, err)
+			continue
+		}
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			if testcase.part >= 0 {
+				t.Errorf("No ParseError for %q: got: %#v", testcase.body, err)
+			}
+			continue
+		}
+		if pe.Part != testcase.part {
+			t.Errorf("Invalid part for %q: expected: %d, got: %d", testcase.body, testcase.part, pe.Part)
+		}
+		if pe.Offset < 0 || pe.Offset > int64(len(testcase.body)) {
+			t.Errorf("Invalid offset for %q: got: %d", testcase.body, pe.Offset)
+		}
+	}
+
+	_, err := ParseBytes("multipart/form-data; boundary=b", []byte(value+"--b\r\nContent-Disposition: form-data; name=\"b\"\r\n\r\nval"))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Cause not reported: expected: %v, got: %v", io.ErrUnexpectedEOF, err)
+	}
+
+	r := testRequestValidContentType(t)
+	r.Body = http.MaxBytesReader(nil, r.Body, 100)
+	if _, err := ParseMax(r, DefaultParseMaxMemory); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrBodyTooLarge, err)
+	}
+
+	r = testRequestValidContentType(t)
+	r.Header.Set("Content-Type", "multipart/form-data")
+	if _, err := ParseMax(r, DefaultParseMaxMemory); !errors.Is(err, ErrMissingBoundary) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrMissingBoundary, err)
+	}
+
+	errHandler := errors.New("handler failed")
+	_, err = ParseStream(testRequestValidContentType(t), func(*FormData, *StreamPart) error { return errHandler })
+	if err != errHandler {
+		t.Errorf("Handler error changed: expected: %v, got: %v", errHandler, err)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import "time"
+
+// ParseOptions configures ParseWithOptions. Limits with a zero value are not
+// enforced.
+type ParseOptions struct {
+	// MaxMemory is the maximum number of bytes of file parts stored in memory,
+	// the remainder is stored in FileStore. If MaxMemory is zero,
+	// DefaultParseMaxMemory is used, if it is negative, all files are stored
+	// in FileStore.
+	MaxMemory int64
+
+	// FileStore stores the file parts which exceed MaxMemory. If FileStore is
+	// nil, the files are stored on disk in temporary files.
+	FileStore FileStore
+
+	// MaxTotalBytes limits the size of the whole request body.
+	MaxTotalBytes int64
+
+	// MaxFileBytes limits the size of a single file part.
+	MaxFileBytes int64
+
+	// MaxValueBytes limits the size of a single value part.
+	MaxValueBytes int64
+
+	// MaxParts limits the number of parts including file parts.
+	MaxParts int
+
+	// MaxFiles limits the number of file parts.
+	MaxFiles int
+
+	// MaxDecompressedBytes limits the size of a part sent with a
+	// Content-Encoding like gzip after decompression, which protects against
+	// decompression bombs. If MaxDecompressedBytes is zero,
+	// DefaultMaxDecompressedBytes is used, if it is negative, decompressed
+	// parts are not limited.
+	MaxDecompressedBytes int64
+
+	// MaxKeyLength limits the length of the form-data key of a part.
+	MaxKeyLength int
+
+	// MinBytesPerSecond is the minimum average rate the body is read with,
+	// after MinRateGracePeriod. Only the time spent waiting for the body
+	// counts, not the time spent storing its files.
+	//
+	// Exceeding MinBytesPerSecond or MaxIdleTime frees the handler, but the
+	// abandoned read and the connection stay blocked until the client sends
+	// more data. Set http.Server.ReadTimeout to cut off slow clients.
+	MinBytesPerSecond int64
+
+	// MinRateGracePeriod is the time the body may stay below
+	// MinBytesPerSecond, e.g. to account for slow starts. If
+	// MinRateGracePeriod is zero, DefaultMinRateGracePeriod is used.
+	MinRateGracePeriod time.Duration
+
+	// MaxIdleTime limits the time a single read may wait for the body, see
+	// MinBytesPerSecond for the blocked read.
+	MaxIdleTime time.Duration
+
+	// Duplicates is the DuplicatePolicy applied to all keys after parsing,
+	// see Validation.Duplicates.
+	Duplicates DuplicatePolicy
+
+	// KeyDuplicates overrides Duplicates for individual keys.
+	KeyDuplicates map[string]DuplicatePolicy
+
+	// Strict adds a validation error for every key not declared by the
+	// policy after parsing, see FormData.ValidateStrict.
+	Strict *StrictPolicy
+
+	// KeyStyle is the notation of nested keys of the returned FormData, see
+	// FormData.SetKeyStyle.
+	KeyStyle KeyStyle
+
+	// Hashes are the digests computed for every file part while parsing, see
+	// File.Sum.
+	Hashes []HashAlgorithm
+
+	// Progress is called with the number of bytes read and the part
+	// currently read while the body is parsed, at most once per
+	// ProgressInterval and once more after parsing finished. Progress is
+	// called synchronously, it should return quickly.
+	Progress func(Progress)
+
+	// ProgressInterval is the minimum time between two calls of Progress. If
+	// ProgressInterval is zero, DefaultProgressInterval is used, if it is
+	// negative, Progress is called on every read.
+	ProgressInterval time.Duration
+
+	// DefaultCharset is the charset of value parts which neither declare a
+	// charset in their Content-Type header nor follow a "_charset_" field, and
+	// of application/x-www-form-urlencoded bodies whose Content-Type declares
+	// no charset. If DefaultCharset is empty, UTF-8 is used. Supported
+	// charsets are UTF-8, ISO-8859-1, Windows-1252 and UTF-16, all values are
+	// converted to UTF-8.
+	DefaultCharset string
+
+	// RejectInvalidUTF8 fails parsing with ErrInvalidUTF8 if a UTF-8 value
+	// contains invalid UTF-8, instead of replacing the invalid bytes with
+	// utf8.RuneError.
+	RejectInvalidUTF8 bool
+
+	// AutoClose closes the returned FormData as soon as the context of the
+	// request is done, that is when the handler returns or the client
+	// disconnects. See FormData.CloseOnDone.
+	AutoClose bool
+
+	// AllowURLEncoded enables parsing application/x-www-form-urlencoded
+	// bodies. Their key-value pairs are added to FormData.Value and count as
+	// value parts for all limits.
+	AllowURLEncoded bool
+}
+
+func (o ParseOptions) maxMemory() int64 {
+	if o.MaxMemory == 0 {
+		return DefaultParseMaxMemory
+	}
+	if o.MaxMemory < 0 {
+		return 0
+	}
+	return o.MaxMemory
+}
+
+func (o ParseOptions) fileStore() FileStore {
+	if o.FileStore == nil {
+		return defaultFileStore
+	}
+	return o.FileStore
+}
+
+func (o ParseOptions) maxDecompressedBytes() int64 {
+	if o.MaxDecompressedBytes == 0 {
+		return DefaultMaxDecompressedBytes
+	}
+	return o.MaxDecompressedBytes
+}
@@ -0,0 +1,278 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+)
+
+const (
+	// maxValueMemory is the maximum number of bytes of all value parts the
+	// parser keeps in memory.
+	maxValueMemory int64 = 10 << 20 // 10 MiB, same as mime/multipart
+)
+
+// parser reads a multipart/form-data body part by part into a FormData.
+type parser struct {
+	reader  *multipart.Reader
+	opts    ParseOptions
+	handler PartHandler
+	data    *FormData
+
+	// remaining number of bytes for value parts and files in memory
+	valueMemory int64
+	fileMemory  int64
+
+	parts int
+	files int
+
+	// charset submitted by a "_charset_" field
+	charset string
+
+	// progress reports the part currently read, may be nil
+	progress *progressReader
+
+	// body counts the bytes read from the body, may be nil
+	body *countReader
+}
+
+// handlerError marks an error returned by a PartHandler, which is returned
+// unchanged.
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string { return e.err.Error() }
+
+func newParser(reader *multipart.Reader, opts ParseOptions, handler PartHandler) *parser {
+	return &parser{
+		reader:      reader,
+		opts:        opts,
+		handler:     handler,
+		data:        newFormData(),
+		valueMemory: maxValueMemory,
+		fileMemory:  opts.maxMemory(),
+	}
+}
+
+// parse reads all parts of the body and returns the resulting FormData. If
+// parsing fails, all files stored so far are removed.
+func (p *parser) parse() (*FormData, error) {
+	for {
+		index := p.parts
+		part, err := p.reader.NextRawPart()
+		if err == io.EOF {
+			return p.data, nil
+		}
+		if err == nil {
+			err = p.parsePart(part)
+			part.Close()
+		}
+		if err != nil {
+			p.data.RemoveAll()
+			if he, ok := err.(*handlerError); ok {
+				return nil, he.err
+			}
+			offset := int64(-1)
+			if p.body != nil {
+				offset = p.body.n
+			}
+			return nil, newParseError(err, index, offset)
+		}
+	}
+}
+
+func (p *parser) parsePart(part *multipart.Part) error {
+	p.parts++
+	if p.opts.MaxParts > 0 && p.parts > p.opts.MaxParts {
+		return ErrTooManyParts
+	}
+
+	name := part.FormName()
+	if name == "" {
+		return nil
+	}
+	if p.opts.MaxKeyLength > 0 && len(name) > p.opts.MaxKeyLength {
+		return ErrKeyTooLong
+	}
+
+	filename, _ := dispositionFilename(part.Header.Get("Content-Disposition"))
+	p.progress.setPart(name, filename)
+	transfer, content := partEncodings(part.Header)
+	info := &Part{
+		Name:             name,
+		Filename:         filename,
+		Header:           part.Header,
+		Index:            p.parts - 1,
+		IsFile:           filename != "",
+		TransferEncoding: transfer,
+		ContentEncoding:  content,
+	}
+	p.data.parts = append(p.data.parts, info)
+
+	r, err := decodePart(part, transfer, content, p.opts.maxDecompressedBytes())
+	if err != nil {
+		return err
+	}
+
+	if filename == "" {
+		value, err := p.readValue(r, part.Header)
+		if err != nil {
+			return err
+		}
+		if name == charsetField {
+			p.charset = value
+		}
+		p.data.Value[name] = append(p.data.Value[name], value)
+		return p.handle(info, r)
+	}
+
+	p.files++
+	if p.opts.MaxFiles > 0 && p.files > p.opts.MaxFiles {
+		return ErrTooManyFiles
+	}
+	if p.handler == nil {
+		return p.storeFile(name, filename, r, part.Header)
+	}
+	return p.handle(info, r)
+}
+
+// handle calls the PartHandler, if set, with a StreamPart reading the decoded
+// content r.
+func (p *parser) handle(part *Part, r io.Reader) error {
+	if p.handler == nil {
+		return nil
+	}
+	if err := p.handler(p.data, &StreamPart{Part: part, r: r}); err != nil {
+		return &handlerError{err}
+	}
+	return nil
+}
+
+// readValue reads the content of a value part while keeping the total size of
+// all values below maxValueMemory. The content is converted from the charset of
+// the part to UTF-8.
+func (p *parser) readValue(r io.Reader, header textproto.MIMEHeader) (string, error) {
+	if p.opts.MaxValueBytes > 0 {
+		r = newLimitReader(r, p.opts.MaxValueBytes, ErrValueTooLarge)
+	}
+
+	var b bytes.Buffer
+	n, err := io.Copy(&b, io.LimitReader(r, lookahead(p.valueMemory)))
+	if err != nil {
+		return "", err
+	}
+	p.valueMemory -= n
+	if p.valueMemory < 0 {
+		return "", ErrBodyTooLarge
+	}
+	return decodeCharset(b.Bytes(), p.valueCharset(header), p.opts.RejectInvalidUTF8)
+}
+
+// valueCharset returns the charset of a value part. The charset of the
+// Content-Type header takes precedence over a preceding "_charset_" field and
+// ParseOptions.DefaultCharset.
+func (p *parser) valueCharset(header textproto.MIMEHeader) string {
+	_, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err == nil && params["charset"] != "" {
+		return params["charset"]
+	}
+	if p.charset != "" {
+		return p.charset
+	}
+	return p.opts.DefaultCharset
+}
+
+// storeFile stores the content of a file part in memory as long as the total
+// size of all files in memory stays below ParseOptions.MaxMemory, otherwise
+// the content is stored in ParseOptions.FileStore.
+func (p *parser) storeFile(name, filename string, r io.Reader, header textproto.MIMEHeader) error {
+	if p.opts.MaxFileBytes > 0 {
+		r = newLimitReader(r, p.opts.MaxFileBytes, ErrFileTooLarge)
+	}
+	sniffer := &sniffReader{r: r}
+	r = sniffer
+	var hashes *hashWriter
+	if len(p.opts.Hashes) > 0 {
+		var err error
+		if hashes, err = newHashWriter(p.opts.Hashes); err != nil {
+			return err
+		}
+		r = io.TeeReader(r, hashes)
+	}
+
+	f := &File{
+		FileHeader: &multipart.FileHeader{
+			Filename: filename,
+			Header:   header,
+		},
+	}
+
+	var b bytes.Buffer
+	n, err := io.Copy(&b, io.LimitReader(r, lookahead(p.fileMemory)))
+	if err != nil {
+		return err
+	}
+	if n <= p.fileMemory {
+		p.fileMemory -= n
+		f.content = b.Bytes()
+		f.Size = n
+	} else {
+		c := &countReader{r: io.MultiReader(&b, r)}
+		store := p.opts.fileStore()
+		key, err := store.Create(c)
+		if err != nil {
+			return err
+		}
+		f.store = store
+		f.key = key
+		f.Size = c.n
+	}
+
+	f.sniffedType = sniffer.contentType()
+	if hashes != nil {
+		f.sums = hashes.sums()
+	}
+	p.data.addFile(name, f)
+	return nil
+}
+
+// countReader counts the bytes read from r.
+type countReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
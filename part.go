@@ -0,0 +1,57 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import "net/textproto"
+
+// Part describes a single part of a parsed request body. FormData.Parts
+// returns the parts in the order of the request body.
+type Part struct {
+	// Name is the form-data key of the part.
+	Name string
+
+	// Filename is the filename of file parts.
+	Filename string
+
+	// Header is the MIME header of the part, e.g. for inspecting the
+	// Content-Type of value parts.
+	Header textproto.MIMEHeader
+
+	// Index is the position of the part in the request body, starting at 0.
+	Index int
+
+	// IsFile reports whether the part is a file part.
+	IsFile bool
+
+	// TransferEncoding is the Content-Transfer-Encoding the part was sent
+	// with, e.g. "base64". The content of the part is already decoded.
+	TransferEncoding string
+
+	// ContentEncoding is the Content-Encoding the part was sent with, e.g.
+	// "gzip". The content of the part is already decompressed.
+	ContentEncoding string
+}
@@ -0,0 +1,117 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"io"
+	"time"
+)
+
+const (
+	// DefaultProgressInterval is the minimum time between two calls of
+	// ParseOptions.Progress if ParseOptions.ProgressInterval is zero.
+	DefaultProgressInterval = 100 * time.Millisecond
+)
+
+// Progress describes the state of a request body while it is parsed.
+type Progress struct {
+	// BytesRead is the number of bytes read from the body so far, including
+	// boundaries and part headers.
+	BytesRead int64
+
+	// ContentLength is the expected size of the body or -1 if it is unknown.
+	ContentLength int64
+
+	// Name is the form-data key of the part currently read.
+	Name string
+
+	// Filename is the filename of the part currently read, empty for value
+	// parts.
+	Filename string
+
+	// Done reports the last call, after the body has been parsed or parsing
+	// failed.
+	Done bool
+}
+
+// progressReader counts the bytes read from a body and reports them to
+// ParseOptions.Progress at most once per interval.
+type progressReader struct {
+	r        io.Reader
+	fn       func(Progress)
+	interval time.Duration
+	last     time.Time
+	progress Progress
+}
+
+// withProgress wraps r in a progressReader if opts.Progress is set. The
+// returned progressReader is nil otherwise.
+func withProgress(r io.Reader, contentLength int64, opts ParseOptions) (io.Reader, *progressReader) {
+	if opts.Progress == nil {
+		return r, nil
+	}
+	interval := opts.ProgressInterval
+	if interval == 0 {
+		interval = DefaultProgressInterval
+	}
+	p := &progressReader{
+		r:        r,
+		fn:       opts.Progress,
+		interval: interval,
+		last:     time.Now(),
+		progress: Progress{ContentLength: contentLength},
+	}
+	return p, p
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.progress.BytesRead += int64(n)
+	if now := time.Now(); n > 0 && now.Sub(p.last) >= p.interval {
+		p.last = now
+		p.fn(p.progress)
+	}
+	return n, err
+}
+
+// setPart records the part currently read.
+func (p *progressReader) setPart(name, filename string) {
+	if p == nil {
+		return
+	}
+	p.progress.Name = name
+	p.progress.Filename = filename
+}
+
+// finish reports the final progress.
+func (p *progressReader) finish() {
+	if p == nil {
+		return
+	}
+	p.progress.Done = true
+	p.fn(p.progress)
+}
@@ -0,0 +1,103 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProgress(t *testing.T) {
+	r := testRequestValidContentType(t)
+	contentLength := r.ContentLength
+
+	var calls []Progress
+	_, err := ParseWithOptions(r, ParseOptions{
+		Progress:         func(p Progress) { calls = append(calls, p) },
+		ProgressInterval: -1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) < 2 {
+		t.Fatalf("Invalid number of calls: expected: >=2, got: %d", len(calls))
+	}
+
+	var bytesRead int64
+	files := 0
+	for i, p := range calls {
+		if p.ContentLength != contentLength {
+			t.Errorf("Invalid ContentLength: expected: %d, got: %d", contentLength, p.ContentLength)
+		}
+		if p.BytesRead < bytesRead {
+			t.Errorf("BytesRead decreased: expected: >=%d, got: %d", bytesRead, p.BytesRead)
+		}
+		bytesRead = p.BytesRead
+		if p.Done != (i == len(calls)-1) {
+			t.Errorf("Invalid Done of call %d: got: %t", i, p.Done)
+		}
+		if p.Filename != "" {
+			files++
+		}
+	}
+	if files == 0 {
+		t.Errorf("No call while reading a file")
+	}
+	last := calls[len(calls)-1]
+	if last.BytesRead != contentLength {
+		t.Errorf("Invalid BytesRead: expected: %d, got: %d", contentLength, last.BytesRead)
+	}
+	if last.Name != "attachment" || last.Filename != "test_binary.bin" {
+		t.Errorf("Invalid part: expected: attachment/test_binary.bin, got: %s/%s", last.Name, last.Filename)
+	}
+
+	calls = nil
+	_, err = ParseWithOptions(testRequestValidContentType(t), ParseOptions{
+		Progress:         func(p Progress) { calls = append(calls, p) },
+		ProgressInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 1 || !calls[0].Done {
+		t.Errorf("Invalid calls: expected: final call only, got: %+v", calls)
+	}
+
+	r, boundary := testRequestWithMultipartForm(t)
+	calls = nil
+	_, err = ParseReader(r.Body, boundary, ParseOptions{
+		Progress: func(p Progress) { calls = append(calls, p) },
+		MaxFiles: 1,
+	})
+	if !errors.Is(err, ErrTooManyFiles) {
+		t.Errorf("Invalid error: expected: %v, got: %v", ErrTooManyFiles, err)
+	}
+	if len(calls) == 0 || !calls[len(calls)-1].Done || calls[len(calls)-1].ContentLength != -1 {
+		t.Errorf("Invalid final call: got: %+v", calls)
+	}
+}
@@ -0,0 +1,258 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// sniffLen is the number of bytes used to detect the content type, same
+	// as http.DetectContentType.
+	sniffLen = 512
+
+	octetStream = "application/octet-stream"
+)
+
+// magicNumbers extend http.DetectContentType with signatures of formats which
+// are commonly used to disguise uploads.
+var magicNumbers = []struct {
+	sig         []byte
+	contentType string
+}{
+	{[]byte("MZ"), "application/vnd.microsoft.portable-executable"},
+	{[]byte("\x7FELF"), "application/x-elf"},
+	{[]byte("\xCA\xFE\xBA\xBE"), "application/x-mach-binary"},
+	{[]byte("\xCF\xFA\xED\xFE"), "application/x-mach-binary"},
+	{[]byte("II*\x00"), "image/tiff"},
+	{[]byte("MM\x00*"), "image/tiff"},
+	{[]byte("BZh"), "application/x-bzip2"},
+	{[]byte("\xFD7zXZ\x00"), "application/x-xz"},
+	{[]byte("7z\xBC\xAF\x27\x1C"), "application/x-7z-compressed"},
+	{[]byte("SQLite format 3\x00"), "application/vnd.sqlite3"},
+}
+
+// contentTypeAliases maps commonly declared non-standard content types to the
+// ones returned by detectContentType.
+var contentTypeAliases = map[string]string{
+	"image/jpg":                    "image/jpeg",
+	"image/pjpeg":                  "image/jpeg",
+	"image/x-png":                  "image/png",
+	"image/x-ms-bmp":               "image/bmp",
+	"image/vnd.microsoft.icon":     "image/x-icon",
+	"audio/wav":                    "audio/wave",
+	"audio/x-wav":                  "audio/wave",
+	"audio/mp3":                    "audio/mpeg",
+	"application/x-zip-compressed": "application/zip",
+	"application/x-gzip":           "application/x-gzip",
+	"application/gzip":             "application/x-gzip",
+	"application/x-pdf":            "application/pdf",
+	"application/x-msdownload":     "application/vnd.microsoft.portable-executable",
+	"application/x-dosexec":        "application/vnd.microsoft.portable-executable",
+	"application/x-executable":     "application/x-elf",
+	"application/x-sqlite3":        "application/vnd.sqlite3",
+}
+
+// zipBasedTypes are formats which are detected as application/zip.
+var zipBasedTypes = map[string]bool{
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         true,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": true,
+	"application/vnd.oasis.opendocument.text":                                   true,
+	"application/vnd.oasis.opendocument.spreadsheet":                            true,
+	"application/vnd.oasis.opendocument.presentation":                           true,
+	"application/epub+zip":                    true,
+	"application/java-archive":                true,
+	"application/vnd.android.package-archive": true,
+}
+
+// textTypes are non text/* formats which are detected as text/plain.
+var textTypes = map[string]bool{
+	"application/json":       true,
+	"application/xml":        true,
+	"application/javascript": true,
+	"application/ecmascript": true,
+	"application/x-yaml":     true,
+	"application/yaml":       true,
+	"application/x-sh":       true,
+	"application/sql":        true,
+	"application/x-ndjson":   true,
+}
+
+// detectableTypes are the binary formats detected by http.DetectContentType.
+var detectableTypes = map[string]bool{
+	"image/x-icon": true, "image/bmp": true, "image/gif": true, "image/webp": true,
+	"image/png": true, "image/jpeg": true, "audio/aiff": true, "audio/mpeg": true,
+	"audio/midi": true, "audio/wave": true, "video/avi": true, "video/mp4": true,
+	"video/webm": true, "application/ogg": true, "application/pdf": true,
+	"application/postscript": true, "application/zip": true, "application/x-gzip": true,
+	"application/x-rar-compressed": true, "application/wasm": true,
+	"application/vnd.ms-fontobject": true, "font/ttf": true, "font/otf": true,
+	"font/collection": true, "font/woff": true, "font/woff2": true,
+}
+
+// extensionTypes maps filename extensions to the content types compared by
+// Validation.MatchContentType. Unlike mime.TypeByExtension, the table does not
+// depend on the mime.types files of the system, so validation gives the same
+// result on every host.
+var extensionTypes = map[string]string{
+	".png": "image/png", ".jpg": "image/jpeg", ".jpeg": "image/jpeg",
+	".jpe": "image/jpeg", ".jfif": "image/jpeg", ".gif": "image/gif",
+	".webp": "image/webp", ".bmp": "image/bmp", ".ico": "image/x-icon",
+	".tif": "image/tiff", ".tiff": "image/tiff",
+	".mp3": "audio/mpeg", ".wav": "audio/wave", ".aif": "audio/aiff",
+	".aiff": "audio/aiff", ".mid": "audio/midi", ".midi": "audio/midi",
+	".avi": "video/avi", ".mp4": "video/mp4", ".webm": "video/webm",
+	".ogg": "application/ogg",
+	".ttf": "font/ttf", ".otf": "font/otf", ".ttc": "font/collection",
+	".woff": "font/woff", ".woff2": "font/woff2", ".eot": "application/vnd.ms-fontobject",
+	".pdf": "application/pdf", ".ps": "application/postscript", ".eps": "application/postscript",
+	".zip": "application/zip", ".gz": "application/x-gzip", ".tgz": "application/x-gzip",
+	".rar": "application/x-rar-compressed", ".7z": "application/x-7z-compressed",
+	".bz2": "application/x-bzip2", ".xz": "application/x-xz",
+	".wasm": "application/wasm", ".sqlite": "application/vnd.sqlite3",
+	".exe":  "application/vnd.microsoft.portable-executable",
+	".dll":  "application/vnd.microsoft.portable-executable",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
+	".epub": "application/epub+zip", ".jar": "application/java-archive",
+	".apk": "application/vnd.android.package-archive",
+	".txt": "text/plain", ".csv": "text/csv", ".md": "text/markdown",
+	".html": "text/html", ".htm": "text/html", ".css": "text/css",
+	".xml": "application/xml", ".json": "application/json",
+	".js": "application/javascript", ".yaml": "application/yaml",
+	".yml": "application/yaml", ".svg": "image/svg+xml",
+}
+
+// typeByExtension returns the content type of the extension of filename or
+// an empty string if the extension is unknown.
+func typeByExtension(filename string) string {
+	return extensionTypes[strings.ToLower(filepath.Ext(filename))]
+}
+
+// detectContentType detects the content type of data by its magic bytes. It
+// considers at most the first 512 bytes of data and returns
+// application/octet-stream if the type is unknown.
+func detectContentType(data []byte) string {
+	if len(data) > sniffLen {
+		data = data[:sniffLen]
+	}
+	for _, m := range magicNumbers {
+		if bytes.HasPrefix(data, m.sig) {
+			return m.contentType
+		}
+	}
+	return http.DetectContentType(data)
+}
+
+// sniff reads the beginning of r and returns its content type.
+func sniff(r io.Reader) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(r, sniffLen))
+	if err != nil {
+		return "", err
+	}
+	return detectContentType(b), nil
+}
+
+// sniffReader records the first bytes read from r to detect the content type.
+type sniffReader struct {
+	r   io.Reader
+	buf []byte
+}
+
+func (s *sniffReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if missing := sniffLen - len(s.buf); missing > 0 {
+		if missing > n {
+			missing = n
+		}
+		s.buf = append(s.buf, p[:missing]...)
+	}
+	return n, err
+}
+
+func (s *sniffReader) contentType() string {
+	return detectContentType(s.buf)
+}
+
+// mediaType returns the lower-cased media type of a content type without
+// parameters and with aliases resolved.
+func mediaType(contentType string) string {
+	t, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t = strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	}
+	if alias, ok := contentTypeAliases[t]; ok {
+		return alias
+	}
+	return t
+}
+
+// contentTypesAgree reports whether the claimed content type of a file is
+// compatible with its sniffed content type.
+func contentTypesAgree(sniffed, claimed string) bool {
+	sniffed, claimed = mediaType(sniffed), mediaType(claimed)
+	if sniffed == claimed {
+		return true
+	}
+
+	switch sniffed {
+	case octetStream:
+		// unknown content only contradicts types which can be detected
+		return !detectableType(claimed)
+	case "text/plain":
+		return strings.HasPrefix(claimed, "text/") || textTypes[claimed] ||
+			strings.HasSuffix(claimed, "+json") || strings.HasSuffix(claimed, "+xml")
+	case "text/xml":
+		return claimed == "application/xml" || strings.HasSuffix(claimed, "+xml")
+	case "text/html":
+		return claimed == "application/xhtml+xml"
+	case "application/zip":
+		return zipBasedTypes[claimed] || strings.HasSuffix(claimed, "+zip")
+	}
+	return false
+}
+
+// detectableType reports whether detectContentType is able to detect the
+// given media type.
+func detectableType(t string) bool {
+	for _, m := range magicNumbers {
+		if m.contentType == t {
+			return true
+		}
+	}
+	return detectableTypes[t] || zipBasedTypes[t]
+}
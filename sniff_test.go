@@ -0,0 +1,148 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+var pngHeader = []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR")
+
+func TestDetectContentType(t *testing.T) {
+	testcases := []struct {
+		data     []byte
+		expected string
+	}{
+		{pngHeader, "image/png"},
+		{[]byte("%PDF-1.7"), "application/pdf"},
+		{[]byte("MZ\x90\x00"), "application/vnd.microsoft.portable-executable"},
+		{[]byte("\x7FELF\x02\x01"), "application/x-elf"},
+		{[]byte("hello world"), "text/plain; charset=utf-8"},
+		{[]byte("\x00\x01\x02\x03"), "application/octet-stream"},
+	}
+
+	for _, testcase := range testcases {
+		if got := detectContentType(testcase.data); got != testcase.expected {
+			t.Errorf("Invalid content type of %q: expected: %s, got: %s", testcase.data, testcase.expected, got)
+		}
+	}
+}
+
+func TestContentTypesAgree(t *testing.T) {
+	testcases := []struct {
+		sniffed  string
+		claimed  string
+		expected bool
+	}{
+		{"image/png", "image/png", true},
+		{"image/jpeg", "image/jpg", true},
+		{"text/plain; charset=utf-8", "text/csv", true},
+		{"text/plain; charset=utf-8", "application/json", true},
+		{"text/xml; charset=utf-8", "image/svg+xml", true},
+		{"application/zip", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", true},
+		{"application/octet-stream", "image/heic", true},
+		{"application/octet-stream", "image/png", false},
+		{"application/vnd.microsoft.portable-executable", "image/png", false},
+		{"text/html; charset=utf-8", "image/gif", false},
+		{"image/png", "application/pdf", false},
+	}
+
+	for _, testcase := range testcases {
+		if got := contentTypesAgree(testcase.sniffed, testcase.claimed); got != testcase.expected {
+			t.Errorf("Invalid agreement of %s and %s: expected: %v, got: %v", testcase.sniffed, testcase.claimed, testcase.expected, got)
+		}
+	}
+}
+
+func TestMatchContentType(t *testing.T) {
+	testcases := []struct {
+		filename    string
+		contentType string
+		content     []byte
+		hasErrors   bool
+	}{
+		{"image.png", "image/png", pngHeader, false},
+		{"image", "image/png", pngHeader, false},
+		{"notes.txt", "text/plain", []byte("some notes"), false},
+		{"unknown.bin", "application/octet-stream", []byte("\x00\x01"), false},
+		{"image.png", "image/png", []byte("MZ\x90\x00"), true},
+		{"image.png", "application/octet-stream", []byte("MZ\x90\x00"), true},
+		{"image.gif", "image/png", pngHeader, true},
+		{"IMAGE.PNG", "application/octet-stream", pngHeader, false},
+		{"setup.EXE", "application/octet-stream", pngHeader, true},
+		{"data.custom", "application/octet-stream", pngHeader, false},
+	}
+
+	for _, testcase := range testcases {
+		body := bytes.NewBuffer([]byte{})
+		multipartWriter := multipart.NewWriter(body)
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="upload"; filename="`+testcase.filename+`"`)
+		header.Set("Content-Type", testcase.contentType)
+		w, err := multipartWriter.CreatePart(header)
+		if err != nil {
+			t.Fatalf("CreatePart: %v", err)
+		}
+		w.Write(testcase.content)
+		multipartWriter.Close()
+
+		fd, err := ParseBytes(multipartWriter.FormDataContentType(), body.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fd.ValidateFile("upload").MatchContentType()
+		if fd.HasErrors() != testcase.hasErrors {
+			t.Errorf("Invalid validation of %s (%s): expected errors: %v, got: %s", testcase.filename, testcase.contentType, testcase.hasErrors, strings.Join(fd.Errors(), " "))
+		}
+	}
+}
+
+func TestSniffedType(t *testing.T) {
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{MaxMemory: -1, FileStore: NewMemoryStore()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := fd.GetFile("attachment").First()
+	if got := f.SniffedType(); got != "text/plain; charset=utf-8" {
+		t.Errorf("Invalid sniffed type: expected: %s, got: %s", "text/plain; charset=utf-8", got)
+	}
+
+	// files parsed by mime/multipart are sniffed on demand
+	fd, err = Parse(testRequestValidContentType(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fd.GetFile("attachment").First().SniffedType(); got != "text/plain; charset=utf-8" {
+		t.Errorf("Invalid sniffed type: expected: %s, got: %s", "text/plain; charset=utf-8", got)
+	}
+}
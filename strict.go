@@ -0,0 +1,117 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"mime/multipart"
+	"sort"
+	"strings"
+)
+
+// StrictPolicy declares the keys a request body may contain. Patterns may
+// contain '*', which matches any sequence of characters, e.g. "tags*" matches
+// "tags[]" and "tags[0]".
+type StrictPolicy struct {
+	// Values are the patterns of allowed value keys.
+	Values []string
+
+	// Files are the patterns of allowed file keys.
+	Files []string
+
+	// AllowUnknown are key prefixes which are allowed for values and files
+	// without being declared.
+	AllowUnknown []string
+}
+
+// ValidateStrict adds a validation error for every key of FormData which is
+// not allowed by policy. The "_charset_" field is always allowed.
+func (fd *FormData) ValidateStrict(policy StrictPolicy) {
+	for _, key := range sortedKeys(fd.Value) {
+		if key != charsetField && !policy.allowed(key, policy.Values) {
+			fd.Validate(key).addUnexpectedError()
+		}
+	}
+	for _, key := range sortedFileKeys(fd.File) {
+		if !policy.allowed(key, policy.Files) {
+			fd.ValidateFile(key).addUnexpectedError()
+		}
+	}
+}
+
+func (p StrictPolicy) allowed(key string, patterns []string) bool {
+	for _, prefix := range p.AllowUnknown {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	for _, pattern := range patterns {
+		if matchPattern(pattern, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether key matches pattern, in which '*' matches any
+// sequence of characters. All other characters, including brackets, match
+// themselves.
+func matchPattern(pattern, key string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == key
+	}
+	if !strings.HasPrefix(key, parts[0]) {
+		return false
+	}
+	key = key[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(key, part)
+		if i < 0 {
+			return false
+		}
+		key = key[i+len(part):]
+	}
+	return strings.HasSuffix(key, parts[len(parts)-1])
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedFileKeys(m map[string][]*multipart.FileHeader) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
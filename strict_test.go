@@ -0,0 +1,107 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"mime/multipart"
+	"reflect"
+	"testing"
+)
+
+func TestMatchPattern(t *testing.T) {
+	testcases := []struct {
+		pattern  string
+		key      string
+		expected bool
+	}{
+		{"name", "name", true},
+		{"name", "names", false},
+		{"tags*", "tags[]", true},
+		{"tags*", "tags", true},
+		{"items[*].qty", "items[0].qty", true},
+		{"items[*].qty", "items[0].price", false},
+		{"*.qty", "items.0.qty", true},
+		{"a*a", "a", false},
+		{"*", "anything", true},
+	}
+
+	for _, testcase := range testcases {
+		if got := matchPattern(testcase.pattern, testcase.key); got != testcase.expected {
+			t.Errorf("Invalid match of %q for %q: expected: %t, got: %t", testcase.pattern, testcase.key, testcase.expected, got)
+		}
+	}
+}
+
+func TestValidateStrict(t *testing.T) {
+	fd := emptyFormData()
+	fd.Value["name"] = []string{"neox5"}
+	fd.Value["tags[0]"] = []string{"go"}
+	fd.Value["is_admin"] = []string{"true"}
+	fd.Value["x-trace"] = []string{"abc"}
+	fd.Value["_charset_"] = []string{"utf-8"}
+	fd.File["avatar"] = []*multipart.FileHeader{{Filename: "me.png"}}
+	fd.File["name"] = []*multipart.FileHeader{{Filename: "name.txt"}}
+
+	fd.ValidateStrict(StrictPolicy{
+		Values:       []string{"name", "tags*"},
+		Files:        []string{"avatar"},
+		AllowUnknown: []string{"x-"},
+	})
+
+	expected := []string{
+		"'is_admin': is not an allowed value",
+		"'name': is not an allowed file",
+	}
+	if got := fd.Errors(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Invalid errors: expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestParseWithOptionsStrict(t *testing.T) {
+	fd, err := ParseWithOptions(testRequestValidContentType(t), ParseOptions{
+		Strict: &StrictPolicy{
+			Values: []string{"from", "to", "subject", "body"},
+			Files:  []string{"attachment"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fd.HasErrors() {
+		t.Errorf("Unexpected errors: %v", fd.Errors())
+	}
+
+	fd, err = ParseWithOptions(testRequestValidContentType(t), ParseOptions{
+		Strict: &StrictPolicy{Values: []string{"from", "to"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fd.Errors()); got != 3 {
+		t.Errorf("Invalid number of errors: expected: 3, got: %d", got)
+	}
+}
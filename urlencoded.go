@@ -0,0 +1,112 @@
+/*
+ * Created on Sat Oct 17 2026
+ *
+ * MIT License
+ *
+ * Copyright (c) 2026, Christian Faustmann / neox5, <faustmannchr@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package formdata
+
+import (
+	"io"
+	"net/textproto"
+	"net/url"
+	"strings"
+)
+
+const (
+	// maxURLEncodedBytes is the maximum size of an
+	// application/x-www-form-urlencoded body if ParseOptions.MaxTotalBytes is
+	// not set.
+	maxURLEncodedBytes int64 = 10 << 20 // 10 MiB, same as net/http
+)
+
+func isURLEncoded(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/x-www-form-urlencoded")
+}
+
+// parseURLEncoded parses an application/x-www-form-urlencoded body into a
+// FormData with an empty FormData.File. The limits of opts apply to the
+// key-value pairs of the body like they apply to parts. Keys and values are
+// converted to UTF-8 like value parts.
+func parseURLEncoded(body io.Reader, opts ParseOptions) (*FormData, error) {
+	maxBytes := opts.MaxTotalBytes
+	if maxBytes == 0 {
+		maxBytes = maxURLEncodedBytes
+	}
+	b, err := io.ReadAll(newLimitReader(body, maxBytes, ErrBodyTooLarge))
+	if err != nil {
+		return nil, newParseError(err, -1, int64(len(b)))
+	}
+
+	fd := newFormData()
+	parts := 0
+	charset := opts.DefaultCharset
+	var offset int64
+	for _, pair := range strings.Split(string(b), "&") {
+		start := offset
+		offset += int64(len(pair)) + 1
+		if pair == "" {
+			continue
+		}
+		parts++
+		fail := func(err error) (*FormData, error) {
+			return nil, newParseError(err, parts-1, start)
+		}
+		if opts.MaxParts > 0 && parts > opts.MaxParts {
+			return fail(ErrTooManyParts)
+		}
+
+		key, value := pair, ""
+		if i := strings.Index(pair, "="); i >= 0 {
+			key, value = pair[:i], pair[i+1:]
+		}
+		if key, err = url.QueryUnescape(key); err != nil {
+			return fail(err)
+		}
+		if opts.MaxKeyLength > 0 && len(key) > opts.MaxKeyLength {
+			return fail(ErrKeyTooLong)
+		}
+		if value, err = url.QueryUnescape(value); err != nil {
+			return fail(err)
+		}
+		if opts.MaxValueBytes > 0 && int64(len(value)) > opts.MaxValueBytes {
+			return fail(ErrValueTooLarge)
+		}
+		if key, err = decodeCharset([]byte(key), charset, opts.RejectInvalidUTF8); err != nil {
+			return fail(err)
+		}
+		if value, err = decodeCharset([]byte(value), charset, opts.RejectInvalidUTF8); err != nil {
+			return fail(err)
+		}
+		if key == charsetField {
+			charset = value
+		}
+		fd.Value[key] = append(fd.Value[key], value)
+		fd.parts = append(fd.parts, &Part{
+			Name:   key,
+			Header: make(textproto.MIMEHeader),
+			Index:  parts - 1,
+		})
+	}
+	return fd, nil
+}
@@ -29,6 +29,8 @@ package formdata
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"time"
 )
 
 var emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
@@ -61,7 +63,7 @@ func (v *Validation) HasN(count int) *Validation {
 		got := len(v.data.GetFile(v.key))
 		if got != count {
 			msg := fmt.Sprintf("Invalid number of elements: expected: %d, got: %d", count, got)
-			v.addError(v.key, msg)
+			v.addCountError(msg)
 		}
 		return v
 	}
@@ -69,7 +71,7 @@ func (v *Validation) HasN(count int) *Validation {
 	got := len(v.data.Get(v.key))
 	if got != count {
 		msg := fmt.Sprintf("Invalid number of elements: expected: %d, got: %d", count, got)
-		v.addError(v.key, msg)
+		v.addCountError(msg)
 	}
 	return v
 }
@@ -80,7 +82,7 @@ func (v *Validation) HasNMin(count int) *Validation {
 		got := len(v.data.GetFile(v.key))
 		if got < count {
 			msg := fmt.Sprintf("Invalid number of elements: expected: >=%d, got: %d", count, got)
-			v.addError(v.key, msg)
+			v.addCountError(msg)
 		}
 		return v
 	}
@@ -88,7 +90,7 @@ func (v *Validation) HasNMin(count int) *Validation {
 	got := len(v.data.Get(v.key))
 	if got < count {
 		msg := fmt.Sprintf("Invalid number of elements: expected: >=%d, got: %d", count, got)
-		v.addError(v.key, msg)
+		v.addCountError(msg)
 	}
 	return v
 }
@@ -128,3 +130,93 @@ func (v *Validation) MatchAllEmail() *Validation {
 	v.MatchAll(emailRegex)
 	return v
 }
+
+// IsInt validates if all elements of the value are integers.
+func (v *Validation) IsInt() *Validation {
+	return v.isType("IsInt", "int", func(s string) error {
+		_, err := strconv.Atoi(s)
+		return err
+	})
+}
+
+// IsUint validates if all elements of the value are unsigned integers.
+func (v *Validation) IsUint() *Validation {
+	return v.isType("IsUint", "uint", func(s string) error {
+		_, err := strconv.ParseUint(s, 10, 0)
+		return err
+	})
+}
+
+// IsFloat validates if all elements of the value are floating point numbers.
+func (v *Validation) IsFloat() *Validation {
+	return v.isType("IsFloat", "float64", func(s string) error {
+		_, err := strconv.ParseFloat(s, 64)
+		return err
+	})
+}
+
+// IsBool validates if all elements of the value are booleans, see
+// FormDataValue.Bool.
+func (v *Validation) IsBool() *Validation {
+	return v.isType("IsBool", "bool", func(s string) error {
+		_, err := parseBool(s)
+		return err
+	})
+}
+
+// IsTime validates if all elements of the value are times in the given
+// layout.
+func (v *Validation) IsTime(layout string) *Validation {
+	return v.isType("IsTime", "time.Time", func(s string) error {
+		_, err := time.Parse(layout, s)
+		return err
+	})
+}
+
+// IsDuration validates if all elements of the value are durations.
+func (v *Validation) IsDuration() *Validation {
+	return v.isType("IsDuration", "time.Duration", func(s string) error {
+		_, err := time.ParseDuration(s)
+		return err
+	})
+}
+
+func (v *Validation) isType(name, typeName string, convert func(s string) error) *Validation {
+	if v.isFile {
+		panic(name + " is not supported for file validation!")
+	}
+
+	for i, el := range v.data.Get(v.key) {
+		if convert(el) != nil {
+			v.addConversionError(i, typeName)
+		}
+	}
+	return v
+}
+
+// MatchContentType validates if the sniffed content type of all files agrees
+// with their declared Content-Type and the type of their filename extension.
+// It rejects spoofed uploads, like an executable declared as image/png.
+// Extensions are looked up in a built-in table instead of the mime.types
+// files of the system, unknown extensions are not checked.
+func (v *Validation) MatchContentType() *Validation {
+	if !v.isFile {
+		panic("MatchContentType is not supported for value validation!")
+	}
+
+	for i, f := range v.data.GetFile(v.key) {
+		sniffed := f.SniffedType()
+		if declared := f.ContentType(); declared != "" && mediaType(declared) != octetStream {
+			if !contentTypesAgree(sniffed, declared) {
+				v.addContentTypeError(i, sniffed, declared)
+				continue
+			}
+		}
+		if byExt := typeByExtension(f.Filename); byExt != "" {
+			if !contentTypesAgree(sniffed, byExt) {
+				v.addContentTypeError(i, sniffed, byExt)
+			}
+		}
+	}
+	return v
+}
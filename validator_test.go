@@ -277,3 +277,42 @@ func TestMatchAllEmail(t *testing.T) {
 		t.Errorf("Error matchall email: got: %s", got)
 	}
 }
+
+func TestTypeValidation(t *testing.T) {
+	testcases := []struct {
+		name     string
+		values   []string
+		validate func(v *Validation)
+		errors   []string
+	}{
+		{"IsInt", []string{"1", "-2"}, func(v *Validation) { v.IsInt() }, nil},
+		{"IsInt invalid", []string{"1", "x", "1.5"}, func(v *Validation) { v.IsInt() }, []string{"Element 1 is not a valid int", "Element 2 is not a valid int"}},
+		{"IsUint invalid", []string{"-1"}, func(v *Validation) { v.IsUint() }, []string{"Element 0 is not a valid uint"}},
+		{"IsFloat", []string{"1.5", "2"}, func(v *Validation) { v.IsFloat() }, nil},
+		{"IsBool", []string{"on", "false"}, func(v *Validation) { v.IsBool() }, nil},
+		{"IsBool invalid", []string{"yes"}, func(v *Validation) { v.IsBool() }, []string{"Element 0 is not a valid bool"}},
+		{"IsTime", []string{"2026-10-17"}, func(v *Validation) { v.IsTime("2006-01-02") }, nil},
+		{"IsTime invalid", []string{"17.10.2026"}, func(v *Validation) { v.IsTime("2006-01-02") }, []string{"Element 0 is not a valid time.Time"}},
+		{"IsDuration invalid", []string{"1 hour"}, func(v *Validation) { v.IsDuration() }, []string{"Element 0 is not a valid time.Duration"}},
+	}
+
+	for _, testcase := range testcases {
+		fd := emptyFormData()
+		fd.Value["key"] = testcase.values
+
+		testcase.validate(fd.Validate("key"))
+		errs := fd.ValidationErrors()
+		if len(errs) != len(testcase.errors) {
+			t.Errorf("%s returned invalid number of errors: expected: %d, got: %d", testcase.name, len(testcase.errors), len(errs))
+			continue
+		}
+		for i, err := range errs {
+			if err.Code() != CodeConversion {
+				t.Errorf("%s returned invalid code: expected: %v, got: %v", testcase.name, CodeConversion, err.Code())
+			}
+			if err.Message() != testcase.errors[i] {
+				t.Errorf("%s returned invalid message: expected: %s, got: %s", testcase.name, testcase.errors[i], err.Message())
+			}
+		}
+	}
+}
@@ -31,33 +31,118 @@ import (
 	"regexp"
 )
 
+// ValidationCode identifies the kind of a ValidationError independent of its
+// message.
+type ValidationCode string
+
+const (
+	// CodeRequired is the code of errors of Required.
+	CodeRequired ValidationCode = "required"
+
+	// CodeCount is the code of errors of HasN and HasNMin.
+	CodeCount ValidationCode = "count"
+
+	// CodeMatch is the code of errors of Match, MatchAll, MatchEmail and
+	// MatchAllEmail.
+	CodeMatch ValidationCode = "match"
+
+	// CodeContentType is the code of errors of MatchContentType.
+	CodeContentType ValidationCode = "content_type"
+
+	// CodeDuplicate is the code of errors of Duplicates.
+	CodeDuplicate ValidationCode = "duplicate"
+
+	// CodeNotAllowed is the code of errors of ValidateStrict.
+	CodeNotAllowed ValidationCode = "not_allowed"
+
+	// CodeConversion is the code of errors of values which cannot be
+	// converted by Bind, Field or the type validations like IsInt.
+	CodeConversion ValidationCode = "conversion"
+
+	// CodeRange is the code of errors of Field.Min and Field.Max.
+	CodeRange ValidationCode = "range"
+
+	// CodeInvalid is the code of errors of Field.Check.
+	CodeInvalid ValidationCode = "invalid"
+)
+
+// ValidationError is a failed validation of a form-data key.
 type ValidationError struct {
 	key     string
+	code    ValidationCode
 	message string
 }
 
+// Key returns the form-data key of the error.
+func (ve ValidationError) Key() string {
+	return ve.key
+}
+
+// Code returns the kind of the error.
+func (ve ValidationError) Code() ValidationCode {
+	return ve.code
+}
+
+// Message returns the error message without key.
+func (ve ValidationError) Message() string {
+	return ve.message
+}
+
 func (ve ValidationError) String() string {
 	return fmt.Sprintf("'%s': %s", ve.key, ve.message)
 }
 
-func (v *Validation) addError(key, msg string) {
+// Error implements error, e.g. for the errors returned by Unmarshal.
+func (ve ValidationError) Error() string {
+	return ve.String()
+}
+
+func (v *Validation) addError(key string, code ValidationCode, msg string) {
 	err := &ValidationError{
 		key:     key,
+		code:    code,
 		message: msg,
 	}
 	v.data.errors = append(v.data.errors, err)
 }
 
 func (v *Validation) addRequiredError(key string) {
-	v.addError(v.key, "is required")
+	v.addError(v.key, CodeRequired, "is required")
 }
 
 func (v *Validation) addMatchError(rx *regexp.Regexp) {
 	msg := fmt.Sprintf("does not match: %s", rx.String())
-	v.addError(v.key, msg)
+	v.addError(v.key, CodeMatch, msg)
 }
 
 func (v *Validation) addMatchAtIndexError(index int, rx *regexp.Regexp) {
 	msg := fmt.Sprintf("Element %d does not match: %s", index, rx.String())
-	v.addError(v.key, msg)
+	v.addError(v.key, CodeMatch, msg)
+}
+
+func (v *Validation) addContentTypeError(index int, sniffed, claimed string) {
+	msg := fmt.Sprintf("Element %d content type %s does not match: %s", index, mediaType(sniffed), mediaType(claimed))
+	v.addError(v.key, CodeContentType, msg)
+}
+
+func (v *Validation) addDuplicateError(count int) {
+	msg := fmt.Sprintf("is duplicated: expected: 1, got: %d", count)
+	v.addError(v.key, CodeDuplicate, msg)
+}
+
+func (v *Validation) addUnexpectedError() {
+	if v.isFile {
+		v.addError(v.key, CodeNotAllowed, "is not an allowed file")
+		return
+	}
+	v.addError(v.key, CodeNotAllowed, "is not an allowed value")
+}
+
+func (v *Validation) addConversionError(index int, typeName string) {
+	msg := fmt.Sprintf("Element %d is not a valid %s", index, typeName)
+	v.addError(v.key, CodeConversion, msg)
+}
+
+func (v *Validation) addCountError(msg string) {
+	v.addError(v.key, CodeCount, msg)
 }